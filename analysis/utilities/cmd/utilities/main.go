@@ -0,0 +1,14 @@
+// Command utilities runs the zforge utilities analyzer. It can be used on
+// its own or as a vet tool:
+//
+//	go vet -vettool=$(which utilities) ./...
+package main
+
+import (
+	"github.com/computesdk/zforge/analysis/utilities"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(utilities.Analyzer)
+}
@@ -0,0 +1,62 @@
+package a
+
+import (
+	"github.com/computesdk/zforge/css"
+	"github.com/computesdk/zforge/html"
+)
+
+// Values a theme adds, as themegen generates them. The analyzer runs
+// without -theme, so they have no rules.
+func Spacing13() css.Spacing { // want Spacing13:`scaleValue\("13"\)`
+	return css.ThemeValue[css.Spacing]("13")
+}
+
+func Shade550() css.Shade { // want Shade550:`scaleValue\("550"\)`
+	return css.ThemeValue[css.Shade]("550")
+}
+
+func W1Of7() css.Width { // want W1Of7:`scaleValue\("1/7"\)`
+	return css.ThemeValue[css.Width]("1/7")
+}
+
+func ShadowHuge() css.ShadowSize { // want ShadowHuge:`scaleValue\("huge"\)`
+	return css.ThemeValue[css.ShadowSize]("huge")
+}
+
+func Opacity33() css.OpacityLevel { // want Opacity33:`scaleValue\("33"\)`
+	return css.ThemeValue[css.OpacityLevel]("33")
+}
+
+func utilities(size css.Spacing) {
+	css.P(css.Spacing4())
+	css.P(Spacing13())                       // want `P: unknown utility "p-13"; valid values for p- are 0, 1, 2`
+	css.P(css.ThemeValue[css.Spacing]("13")) // want `P: unknown utility "p-13"`
+	css.P(size)
+	css.BgBlue(Shade550()) // want `BgBlue: unknown utility "bg-blue-550"`
+	css.W(css.W1Of2())
+	css.W(W1Of7()) // want `W: unknown utility "w-1/7"`
+	css.Shadow()
+	css.Shadow(css.ShadowLg())
+	css.Shadow(ShadowHuge()) // want `Shadow: unknown utility "shadow-huge"`
+	css.Flex()
+}
+
+func variants() {
+	css.Hover(css.BgBlue(css.Shade600()))
+	css.Hover(css.P(Spacing13())) // want `P: unknown utility "p-13"`
+	css.Variant("md", css.Flex())
+	css.Variant("xs", css.Flex()) // want `Variant: unknown variant "xs" in "xs:flex"`
+	css.WArb("37px")
+	css.WArb("1px;color:red") // want `WArb: arbitrary value "1px;color:red" contains ';'`
+	css.Arb("nope", "1px")    // want `Arb: utility "nope" does not accept arbitrary values`
+	css.WithOpacity(css.BgBlue(css.Shade500()), css.Opacity50())
+	css.WithOpacity(css.BgBlue(css.Shade500()), Opacity33()) // want `WithOpacity: unknown opacity "33" in "bg-blue-500/33"; valid values are 0, 5, 10`
+	css.WithOpacity(css.BgBlue(Shade550()), css.Opacity50()) // want `BgBlue: unknown utility "bg-blue-550"`
+	css.Hover(css.WithOpacity(css.BgBlue(css.Shade500()), css.Opacity50()))
+}
+
+func classes(class css.Class) {
+	html.Div().Class("p-4 flex", css.P(css.Spacing4()), class)
+	html.Div().Class("p-4 flex-center") // want `Class: unknown utility "flex-center"`
+	html.Div().Class(css.Class("m-13")) // want `Class: unknown utility "m-13"`
+}
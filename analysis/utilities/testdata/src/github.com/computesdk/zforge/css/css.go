@@ -0,0 +1,107 @@
+// Package css is a stub with the shapes of the generated utility functions
+package css
+
+type Class string
+
+type Spacing struct{ value string }
+
+func Spacing4() Spacing { return Spacing{"4"} }
+
+func (v Spacing) String() string {
+	if v.value == "" {
+		panic("css: zero Spacing value")
+	}
+	return v.value
+}
+
+type Shade struct{ value string }
+
+func Shade500() Shade { return Shade{"500"} }
+
+func Shade600() Shade { return Shade{"600"} }
+
+func (v Shade) String() string { return v.value }
+
+type Width struct{ value string }
+
+func W1Of2() Width { return Width{"1/2"} }
+
+func (v Width) String() string { return v.value }
+
+type ShadowSize struct{ value string }
+
+func ShadowLg() ShadowSize { return ShadowSize{"lg"} }
+
+func (v ShadowSize) String() string { return v.value }
+
+type OpacityLevel struct{ value string }
+
+func Opacity50() OpacityLevel { return OpacityLevel{"50"} }
+
+func (v OpacityLevel) String() string { return v.value }
+
+func ThemeValue[T ~struct{ value string }](value string) T {
+	return T{value}
+}
+
+func trackClass(className string) {}
+
+func P(size Spacing) Class {
+	className := "p-" + size.String()
+	trackClass(className)
+	return Class(className)
+}
+
+func BgBlue(shade Shade) Class {
+	className := "bg-blue-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+func W(size Width) Class {
+	className := "w-" + size.String()
+	trackClass(className)
+	return Class(className)
+}
+
+func Shadow(size ...ShadowSize) Class {
+	var className string
+	if len(size) > 0 {
+		className = "shadow-" + size[0].String()
+	} else {
+		className = "shadow"
+	}
+	trackClass(className)
+	return Class(className)
+}
+
+func Flex() Class {
+	trackClass("flex")
+	return "flex"
+}
+
+func Variant(name string, class Class) Class {
+	className := name + ":" + string(class)
+	trackClass(className)
+	return Class(className)
+}
+
+func Hover(class Class) Class {
+	return Variant("hover", class)
+}
+
+func Arb(prefix, value string) Class {
+	className := prefix + "-[" + value + "]"
+	trackClass(className)
+	return Class(className)
+}
+
+func WArb(value string) Class {
+	return Arb("w", value)
+}
+
+func WithOpacity(class Class, opacity OpacityLevel) Class {
+	className := string(class) + "/" + opacity.String()
+	trackClass(className)
+	return Class(className)
+}
@@ -0,0 +1,10 @@
+// Package html is a stub of the element API
+package html
+
+import "github.com/computesdk/zforge/css"
+
+type Element struct{}
+
+func Div() *Element { return &Element{} }
+
+func (e *Element) Class(classes ...css.Class) *Element { return e }
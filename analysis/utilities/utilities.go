@@ -0,0 +1,472 @@
+// Package utilities defines an analyzer that checks zforge utility calls
+// with constant arguments against the YAML configs, so a class that would
+// generate no CSS, such as css.P(ui.Spacing13) for a theme value without
+// its theme or css.Variant("xs", css.Flex()), is reported at build time.
+//
+// While analyzing the css package the analyzer records how each utility
+// function builds its class name, and the value of each generated scale
+// value such as css.Spacing4(); values themegen generates with
+// css.ThemeValue are recorded in the package declaring them. At each call site it rebuilds
+// the class from the constant arguments and validates it with css.Validate.
+// Constant strings passed to Element.Class are validated as well. Pass
+// -theme with the theme file the program applies with css.SetTheme, so the
+// classes it adds are known.
+package utilities
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/computesdk/zforge/css"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const (
+	cssPath  = "github.com/computesdk/zforge/css"
+	htmlPath = "github.com/computesdk/zforge/html"
+)
+
+var Analyzer = &analysis.Analyzer{
+	Name:      "utilities",
+	Doc:       "check zforge utility calls with constant arguments against the utility config",
+	Requires:  []*analysis.Analyzer{inspect.Analyzer},
+	Run:       run,
+	FactTypes: []analysis.Fact{new(classFunc), new(scaleValue)},
+}
+
+// funcKind describes how a css function builds its class
+type funcKind int
+
+const (
+	// constClass returns Format unchanged, like Flex
+	constClass funcKind = iota
+	// formatClass formats its first argument with Format, like P. If the
+	// argument is variadic and omitted, the class is Default, like Shadow.
+	formatClass
+	// variantClass prefixes its last argument with the variant Format, like
+	// Hover; an empty Format takes the variant from the first argument
+	variantClass
+	// arbClass builds Format-[value], like WArb; an empty Format takes the
+	// prefix from the first argument
+	arbClass
+	// opacityClass adds the opacity in its second argument to the class in
+	// its first, like WithOpacity
+	opacityClass
+)
+
+// classFunc is the fact recorded for each css function that builds a class
+type classFunc struct {
+	Kind    funcKind
+	Format  string
+	Default string
+}
+
+func (*classFunc) AFact() {}
+
+func (f *classFunc) String() string {
+	return "classFunc(" + strconv.Itoa(int(f.Kind)) + ", " + strconv.Quote(f.Format) + ")"
+}
+
+// scaleValue is the fact recorded for each function returning a value of a
+// css value type, such as css.Spacing4
+type scaleValue struct {
+	Value string
+}
+
+func (*scaleValue) AFact() {}
+
+func (v *scaleValue) String() string {
+	return "scaleValue(" + strconv.Quote(v.Value) + ")"
+}
+
+var (
+	themePath string
+	themeOnce sync.Once
+	themeErr  error
+)
+
+func init() {
+	Analyzer.Flags.StringVar(&themePath, "theme", "", "theme YAML file applied with css.SetTheme before checking")
+}
+
+// loadTheme applies the theme given with -theme, once per process
+func loadTheme() error {
+	themeOnce.Do(func() {
+		if themePath == "" {
+			return
+		}
+		data, err := os.ReadFile(themePath)
+		if err != nil {
+			themeErr = err
+			return
+		}
+		if err := css.SetTheme(data); err != nil {
+			themeErr = fmt.Errorf("%s: %w", themePath, err)
+		}
+	})
+	return themeErr
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	if err := loadTheme(); err != nil {
+		return nil, err
+	}
+	exportScaleValues(pass)
+	if pass.Pkg.Path() == cssPath {
+		exportClassFuncs(pass)
+		return nil, nil
+	}
+
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	inspect.Preorder([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node) {
+		call := n.(*ast.CallExpr)
+		fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+		if !ok || fn.Pkg() == nil {
+			return
+		}
+		switch fn.Pkg().Path() {
+		case cssPath:
+			checkUtilityCall(pass, call, fn)
+		case htmlPath:
+			if fn.Name() == "Class" && fn.Type().(*types.Signature).Recv() != nil {
+				checkClassArgs(pass, call)
+			}
+		}
+	})
+	return nil, nil
+}
+
+// checkUtilityCall reports a css call whose class has no rule. A variant or
+// opacity call is only reported if its inner class is valid, so an invalid
+// utility is reported once, at the innermost call.
+func checkUtilityCall(pass *analysis.Pass, call *ast.CallExpr, fn *types.Func) {
+	var fact classFunc
+	if !pass.ImportObjectFact(fn, &fact) {
+		return
+	}
+	class, ok := evalClass(pass, call)
+	if !ok {
+		return
+	}
+	if inner := innerClassArg(fact.Kind, call); inner != nil {
+		class, ok := evalClass(pass, inner)
+		if !ok || css.Validate(css.Class(class)) != nil {
+			return
+		}
+	}
+	if err := css.Validate(css.Class(class)); err != nil {
+		pass.Reportf(call.Pos(), "%s: %v", fn.Name(), err)
+	}
+}
+
+// innerClassArg returns the argument holding the class a wrapper call
+// modifies, or nil for other calls
+func innerClassArg(kind funcKind, call *ast.CallExpr) ast.Expr {
+	switch {
+	case kind == variantClass && len(call.Args) > 0:
+		return call.Args[len(call.Args)-1]
+	case kind == opacityClass && len(call.Args) == 2:
+		return call.Args[0]
+	}
+	return nil
+}
+
+// checkClassArgs reports constant class strings passed to Element.Class
+// that match no utility
+func checkClassArgs(pass *analysis.Pass, call *ast.CallExpr) {
+	for _, arg := range call.Args {
+		tv, ok := pass.TypesInfo.Types[arg]
+		if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+			continue
+		}
+		for _, token := range strings.Fields(constant.StringVal(tv.Value)) {
+			if err := css.Validate(css.Class(token)); err != nil {
+				pass.Reportf(arg.Pos(), "Class: %v", err)
+			}
+		}
+	}
+}
+
+// evalClass returns the class an expression evaluates to, if it is a
+// constant or a css call with constant arguments
+func evalClass(pass *analysis.Pass, expr ast.Expr) (string, bool) {
+	if tv, ok := pass.TypesInfo.Types[expr]; ok && tv.Value != nil {
+		if tv.Value.Kind() != constant.String {
+			return "", false
+		}
+		return constant.StringVal(tv.Value), true
+	}
+
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok {
+		return "", false
+	}
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok {
+		return "", false
+	}
+	var fact classFunc
+	if !pass.ImportObjectFact(fn, &fact) {
+		return "", false
+	}
+
+	switch fact.Kind {
+	case constClass:
+		return fact.Format, true
+	case formatClass:
+		if len(call.Args) == 0 {
+			return fact.Default, fact.Default != ""
+		}
+		arg, ok := constArg(pass, call.Args[0])
+		if !ok {
+			return "", false
+		}
+		// The format has a single %s verb
+		return strings.Replace(fact.Format, "%s", arg, 1), true
+	case variantClass, arbClass:
+		prefix := fact.Format
+		args := call.Args
+		if prefix == "" {
+			if len(args) < 2 {
+				return "", false
+			}
+			if prefix, ok = constArg(pass, args[0]); !ok {
+				return "", false
+			}
+			args = args[1:]
+		}
+		if len(args) != 1 {
+			return "", false
+		}
+		if fact.Kind == arbClass {
+			value, ok := constArg(pass, args[0])
+			return prefix + "-[" + value + "]", ok
+		}
+		inner, ok := evalClass(pass, args[0])
+		return prefix + ":" + inner, ok
+	case opacityClass:
+		if len(call.Args) != 2 {
+			return "", false
+		}
+		inner, ok := evalClass(pass, call.Args[0])
+		if !ok {
+			return "", false
+		}
+		opacity, ok := constArg(pass, call.Args[1])
+		return inner + "/" + opacity, ok
+	}
+	return "", false
+}
+
+// constArg returns the value of a constant string or integer argument, or
+// of a scale value, as it would be formatted into a class
+func constArg(pass *analysis.Pass, arg ast.Expr) (string, bool) {
+	if value, ok := scaleValueOf(pass, arg); ok {
+		return value, true
+	}
+	tv, ok := pass.TypesInfo.Types[arg]
+	if !ok || tv.Value == nil {
+		return "", false
+	}
+	switch tv.Value.Kind() {
+	case constant.String:
+		return constant.StringVal(tv.Value), true
+	case constant.Int:
+		return tv.Value.ExactString(), true
+	}
+	return "", false
+}
+
+// scaleValueOf returns the value of a call to a function with a scaleValue
+// fact, such as css.Spacing4(), or of a css.ThemeValue call with a constant
+// argument
+func scaleValueOf(pass *analysis.Pass, expr ast.Expr) (string, bool) {
+	expr = ast.Unparen(expr)
+	if value, ok := themeValueArg(pass, expr); ok {
+		return value, true
+	}
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) != 0 {
+		return "", false
+	}
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok {
+		return "", false
+	}
+	var fact scaleValue
+	if !pass.ImportObjectFact(fn, &fact) {
+		return "", false
+	}
+	return fact.Value, true
+}
+
+// themeValueArg returns the constant argument of a css.ThemeValue call
+func themeValueArg(pass *analysis.Pass, expr ast.Expr) (string, bool) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return "", false
+	}
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != cssPath || fn.Name() != "ThemeValue" {
+		return "", false
+	}
+	tv, ok := pass.TypesInfo.Types[call.Args[0]]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+	return constant.StringVal(tv.Value), true
+}
+
+// exportScaleValues records a scaleValue fact for each function without
+// parameters that returns a value of a css value type: a literal such as
+// Spacing{"4"} in the css package, or a css.ThemeValue call elsewhere
+func exportScaleValues(pass *analysis.Pass) {
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Recv != nil || fd.Type.Params.NumFields() != 0 || fd.Body == nil || len(fd.Body.List) != 1 {
+				continue
+			}
+			ret, ok := fd.Body.List[0].(*ast.ReturnStmt)
+			if !ok || len(ret.Results) != 1 {
+				continue
+			}
+			value, ok := themeValueArg(pass, ret.Results[0])
+			if lit, isLit := ret.Results[0].(*ast.CompositeLit); isLit && pass.Pkg.Path() == cssPath && len(lit.Elts) == 1 {
+				value, ok = stringLit(lit.Elts[0])
+			}
+			if fn, isFunc := pass.TypesInfo.Defs[fd.Name].(*types.Func); ok && isFunc {
+				pass.ExportObjectFact(fn, &scaleValue{Value: value})
+			}
+		}
+	}
+}
+
+// exportClassFuncs records a classFunc fact for each exported css function
+// that returns a Class built in one of the shapes of the generated code
+func exportClassFuncs(pass *analysis.Pass) {
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Recv != nil || !fd.Name.IsExported() || fd.Body == nil {
+				continue
+			}
+			fn, ok := pass.TypesInfo.Defs[fd.Name].(*types.Func)
+			if !ok || !returnsClass(fn) {
+				continue
+			}
+			if fact, ok := classFuncOf(fd); ok {
+				pass.ExportObjectFact(fn, fact)
+			}
+		}
+	}
+}
+
+// returnsClass reports whether fn returns a single css.Class
+func returnsClass(fn *types.Func) bool {
+	results := fn.Type().(*types.Signature).Results()
+	if results.Len() != 1 {
+		return false
+	}
+	named, ok := results.At(0).Type().(*types.Named)
+	return ok && named.Obj().Name() == "Class"
+}
+
+// wrapperKinds maps the css functions that generated wrappers delegate to
+// onto the kind of class they build
+var wrapperKinds = map[string]funcKind{"Variant": variantClass, "Arb": arbClass, "WithOpacity": opacityClass}
+
+// classFuncOf matches the body of a css function against the shapes used
+// by the generated code
+func classFuncOf(fd *ast.FuncDecl) (*classFunc, bool) {
+	if kind, ok := wrapperKinds[fd.Name.Name]; ok {
+		return &classFunc{Kind: kind}, true
+	}
+
+	var fact *classFunc
+	ast.Inspect(fd.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.ReturnStmt:
+			// return "flex" or return Variant("hover", class)
+			if len(n.Results) != 1 {
+				return true
+			}
+			if lit, ok := stringLit(n.Results[0]); ok && fact == nil {
+				fact = &classFunc{Kind: constClass, Format: lit}
+			}
+			if call, ok := n.Results[0].(*ast.CallExpr); ok && len(call.Args) == 2 {
+				if ident, ok := call.Fun.(*ast.Ident); ok {
+					if k, ok := wrapperKinds[ident.Name]; ok {
+						if lit, ok := stringLit(call.Args[0]); ok {
+							fact = &classFunc{Kind: k, Format: lit}
+						}
+					}
+				}
+			}
+		case *ast.AssignStmt:
+			// className := "p-" + size.String(), or className = "shadow"
+			if len(n.Rhs) != 1 {
+				return true
+			}
+			if lit, ok := stringLit(n.Rhs[0]); ok {
+				if fact == nil {
+					fact = &classFunc{Kind: formatClass}
+				}
+				fact.Default = lit
+			}
+			if format, ok := concatFormat(n.Rhs[0]); ok {
+				if fact == nil {
+					fact = &classFunc{Kind: formatClass}
+				}
+				fact.Kind = formatClass
+				fact.Format = format
+			}
+		}
+		return true
+	})
+	if fact == nil || (fact.Kind == formatClass && fact.Format == "") {
+		return nil, false
+	}
+	return fact, true
+}
+
+// concatFormat returns the format of a class built as a prefix and a value,
+// such as "p-" + size.String() or "shadow-" + size[0].String()
+func concatFormat(expr ast.Expr) (string, bool) {
+	concat, ok := expr.(*ast.BinaryExpr)
+	if !ok || concat.Op != token.ADD {
+		return "", false
+	}
+	prefix, ok := stringLit(concat.X)
+	if !ok {
+		return "", false
+	}
+	call, ok := concat.Y.(*ast.CallExpr)
+	if !ok || len(call.Args) != 0 {
+		return "", false
+	}
+	if sel, ok := call.Fun.(*ast.SelectorExpr); !ok || sel.Sel.Name != "String" {
+		return "", false
+	}
+	return prefix + "%s", true
+}
+
+// stringLit returns the value of a string literal
+func stringLit(expr ast.Expr) (string, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	return s, err == nil
+}
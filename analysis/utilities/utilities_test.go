@@ -0,0 +1,12 @@
+package utilities_test
+
+import (
+	"testing"
+
+	"github.com/computesdk/zforge/analysis/utilities"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), utilities.Analyzer, "a")
+}
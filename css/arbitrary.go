@@ -0,0 +1,30 @@
+package css
+
+import "github.com/computesdk/zforge/css/internal"
+
+// ArbitraryClass builds a utility with a one-off value in brackets, so
+// ArbitraryClass("w", "37px") yields "w-[37px]" and
+// ArbitraryClass("grid-cols", "200px_1fr") yields "grid-cols-[200px_1fr]",
+// with '_' written as a space in the CSS. It returns an error if the
+// utility has no arbitrary form or the value could break out of its
+// declaration, such as one containing ';', '}' or whitespace. Use it for
+// values that are not known at compile time.
+func ArbitraryClass(prefix, value string) (Class, error) {
+	className, err := internal.ArbitraryClass(prefix, value)
+	if err != nil {
+		return "", err
+	}
+	trackClass(className)
+	return Class(className), nil
+}
+
+// Arb is like ArbitraryClass but panics if the value is rejected. The
+// generated helpers like WArb and BgArb call Arb for each utility in the
+// config.
+func Arb(prefix, value string) Class {
+	class, err := ArbitraryClass(prefix, value)
+	if err != nil {
+		panic("css: " + err.Error())
+	}
+	return class
+}
@@ -0,0 +1,85 @@
+// Command themegen generates typed utility functions and scale values
+// for the classes a theme adds to the built-in config, such as BgBrand for
+// a new "brand" color. Run it from the package that should hold them:
+//
+//	//go:generate go run github.com/computesdk/zforge/css/cmd/themegen -theme theme.yaml
+//
+// When the theme file is in the output directory, the generated file embeds
+// it and applies it with css.SetTheme, so importing the package is enough
+// for its classes to get CSS. Otherwise call css.SetTheme at startup.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/computesdk/zforge/css/internal"
+)
+
+func main() {
+	themePath := flag.String("theme", "", "theme YAML file")
+	output := flag.String("o", "zforge_theme.go", "output file")
+	pkg := flag.String("pkg", "", "package name (default: $GOPACKAGE or the output directory name)")
+	flag.Parse()
+
+	if err := run(*themePath, *output, *pkg); err != nil {
+		fmt.Fprintf(os.Stderr, "themegen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(themePath, output, pkg string) error {
+	if themePath == "" {
+		return fmt.Errorf("-theme is required")
+	}
+	if pkg == "" {
+		pkg = os.Getenv("GOPACKAGE")
+	}
+	if pkg == "" {
+		abs, err := filepath.Abs(filepath.Dir(output))
+		if err != nil {
+			return err
+		}
+		pkg = filepath.Base(abs)
+	}
+
+	data, err := os.ReadFile(themePath)
+	if err != nil {
+		return err
+	}
+	theme, err := internal.ParseTheme(data)
+	if err != nil {
+		return err
+	}
+
+	base, err := internal.GenerateUtilitiesCode()
+	if err != nil {
+		return err
+	}
+	if err := internal.SetTheme(theme); err != nil {
+		return err
+	}
+	themed, err := internal.GenerateUtilitiesCode()
+	if err != nil {
+		return err
+	}
+
+	embed := ""
+	if sameDir(themePath, output) {
+		embed = filepath.Base(themePath)
+	}
+	code, err := internal.GenerateThemeCode(base, themed, pkg, embed)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(output, []byte(code), 0644)
+}
+
+// sameDir reports whether two files are in the same directory
+func sameDir(a, b string) bool {
+	dirA, errA := filepath.Abs(filepath.Dir(a))
+	dirB, errB := filepath.Abs(filepath.Dir(b))
+	return errA == nil && errB == nil && dirA == dirB
+}
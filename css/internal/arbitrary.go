@@ -0,0 +1,100 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// ArbitraryClass builds the class name for an arbitrary value utility such
+// as "w-[37px]". It fails if the prefix has no arbitrary form or the value
+// is rejected by ValidateArbitraryValue.
+func ArbitraryClass(prefix, value string) (string, error) {
+	idx, err := loadIndex()
+	if err != nil {
+		return "", err
+	}
+	if _, ok := idx.arbitrary[prefix]; !ok {
+		return "", fmt.Errorf("utility %q does not accept arbitrary values", prefix)
+	}
+	if err := ValidateArbitraryValue(value); err != nil {
+		return "", err
+	}
+	return prefix + "-[" + value + "]", nil
+}
+
+// ValidateArbitraryValue reports whether value can be used inside brackets.
+// Values must be a single class token and must not be able to end the
+// declaration or rule they are written into, so whitespace, ';', '{', '}',
+// backslashes and comment delimiters are rejected, as are unbalanced
+// quotes, brackets and parentheses. Use '_' where the CSS value needs a
+// space.
+func ValidateArbitraryValue(value string) error {
+	if value == "" {
+		return fmt.Errorf("arbitrary value is empty")
+	}
+	for _, delimiter := range []string{"/*", "*/"} {
+		if strings.Contains(value, delimiter) {
+			return fmt.Errorf("arbitrary value %q contains %q", value, delimiter)
+		}
+	}
+	var open []rune
+	var quote rune
+	for _, r := range value {
+		switch {
+		case unicode.IsSpace(r), unicode.IsControl(r):
+			return fmt.Errorf("arbitrary value %q contains whitespace; use '_' for spaces", value)
+		case strings.ContainsRune(";{}\\<>", r):
+			return fmt.Errorf("arbitrary value %q contains %q", value, r)
+		case quote != 0:
+			// Brackets inside a string are literal
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '(' || r == '[':
+			open = append(open, r)
+		case r == ')' || r == ']':
+			want := '('
+			if r == ']' {
+				want = '['
+			}
+			if len(open) == 0 || open[len(open)-1] != want {
+				return fmt.Errorf("arbitrary value %q has unbalanced %q", value, r)
+			}
+			open = open[:len(open)-1]
+		}
+	}
+	if quote != 0 {
+		return fmt.Errorf("arbitrary value %q has unbalanced %q", value, quote)
+	}
+	if len(open) > 0 {
+		return fmt.Errorf("arbitrary value %q has unbalanced %q", value, open[len(open)-1])
+	}
+	return nil
+}
+
+// parseArbitrary splits a class name such as "w-[37px]" into its prefix and
+// bracketed value
+func parseArbitrary(className string) (prefix, value string, ok bool) {
+	i := strings.Index(className, "-[")
+	if i <= 0 || !strings.HasSuffix(className, "]") {
+		return "", "", false
+	}
+	return className[:i], className[i+2 : len(className)-1], true
+}
+
+// arbitraryRule returns the properties of an arbitrary value class, or false
+// if the class is not one or its value is rejected
+func (idx *utilityIndex) arbitraryRule(className string) (string, bool) {
+	prefix, value, ok := parseArbitrary(className)
+	if !ok {
+		return "", false
+	}
+	template, ok := idx.arbitrary[prefix]
+	if !ok || ValidateArbitraryValue(value) != nil {
+		return "", false
+	}
+	return strings.ReplaceAll(template, "{value}", strings.ReplaceAll(value, "_", " ")), true
+}
@@ -18,6 +18,7 @@ type SpacingConfig struct {
 		Properties    []struct {
 			Name        string `yaml:"name"`
 			Prefix      string `yaml:"prefix"`
+			Selector    string `yaml:"selector"`
 			CSSProperty string `yaml:"css_property"`
 		} `yaml:"properties"`
 	} `yaml:"spacing"`
@@ -33,6 +34,10 @@ type LayoutConfig struct {
 			Name        string `yaml:"name"`
 			CSSProperty string `yaml:"css_property"`
 		} `yaml:"display"`
+		Container []struct {
+			Name        string `yaml:"name"`
+			CSSProperty string `yaml:"css_property"`
+		} `yaml:"container"`
 	} `yaml:"layout"`
 	Flexbox struct {
 		Justify []struct {
@@ -51,6 +56,10 @@ type LayoutConfig struct {
 			Name        string `yaml:"name"`
 			CSSProperty string `yaml:"css_property"`
 		} `yaml:"wrap"`
+		Grow []struct {
+			Name        string `yaml:"name"`
+			CSSProperty string `yaml:"css_property"`
+		} `yaml:"grow"`
 	} `yaml:"flexbox"`
 	Grid struct {
 		Cols struct {
@@ -121,9 +130,52 @@ type BordersConfig struct {
 			Name        string `yaml:"name"`
 			CSSProperty string `yaml:"css_property"`
 		} `yaml:"style"`
+		Colors []ColorUtility `yaml:"colors"`
+		Divide struct {
+			Selector   string `yaml:"selector"`
+			Properties []struct {
+				Name        string `yaml:"name"`
+				Prefix      string `yaml:"prefix"`
+				CSSProperty string `yaml:"css_property"`
+			} `yaml:"properties"`
+			Style []struct {
+				Name        string `yaml:"name"`
+				CSSProperty string `yaml:"css_property"`
+			} `yaml:"style"`
+		} `yaml:"divide"`
+		Ring struct {
+			Reset          string `yaml:"reset"`
+			Scale          []int  `yaml:"scale"`
+			Default        int    `yaml:"default"`
+			CSSProperty    string `yaml:"css_property"`
+			OffsetProperty string `yaml:"offset_property"`
+			Special        []struct {
+				Name        string `yaml:"name"`
+				CSSProperty string `yaml:"css_property"`
+			} `yaml:"special"`
+		} `yaml:"ring"`
+		Outline struct {
+			Scale          []int  `yaml:"scale"`
+			CSSProperty    string `yaml:"css_property"`
+			OffsetProperty string `yaml:"offset_property"`
+			Style          []struct {
+				Name        string `yaml:"name"`
+				CSSProperty string `yaml:"css_property"`
+			} `yaml:"style"`
+		} `yaml:"outline"`
 	} `yaml:"borders"`
 }
 
+// ColorUtility is a utility generated for every color, such as
+// border-gray-200. {color} in CSSProperty is the color value, and an
+// optional Selector template such as "{class} > * + *" styles other
+// elements than the one with the class.
+type ColorUtility struct {
+	Prefix      string `yaml:"prefix"`
+	Selector    string `yaml:"selector"`
+	CSSProperty string `yaml:"css_property"`
+}
+
 type SizingConfig struct {
 	Sizing struct {
 		Width struct {
@@ -272,11 +324,128 @@ type EffectsConfig struct {
 				CSSProperty string `yaml:"css_property"`
 			} `yaml:"values"`
 		} `yaml:"screen_readers"`
+		Filters struct {
+			Filter         string           `yaml:"filter"`
+			BackdropFilter string           `yaml:"backdrop_filter"`
+			Functions      []FilterFunction `yaml:"functions"`
+		} `yaml:"filters"`
+		PseudoClasses []struct {
+			Name     string `yaml:"name"`
+			Selector string `yaml:"selector"`
+		} `yaml:"pseudo_classes"`
+		DarkMode DarkMode `yaml:"dark_mode"`
 	} `yaml:"effects"`
 }
 
+// FilterFunction is a filter function and its scale. Its utilities set the
+// --{name} variable read by the filter declaration, and with Backdrop also
+// --backdrop-{name} for the backdrop-filter declaration.
+type FilterFunction struct {
+	Name     string       `yaml:"name"`
+	Type     string       `yaml:"type"`
+	Template string       `yaml:"template"`
+	Backdrop bool         `yaml:"backdrop"`
+	Values   []namedValue `yaml:"values"`
+}
+
+// BreakpointsConfig lists the responsive breakpoints, smallest first
+type BreakpointsConfig struct {
+	Breakpoints []struct {
+		Name     string `yaml:"name"`
+		MinWidth string `yaml:"min_width"`
+	} `yaml:"breakpoints"`
+}
+
+// ArbitraryConfig lists the utilities that accept an arbitrary bracketed
+// value and the declaration each one produces
+type ArbitraryConfig struct {
+	Arbitrary []struct {
+		Prefix      string `yaml:"prefix"`
+		CSSProperty string `yaml:"css_property"`
+	} `yaml:"arbitrary"`
+}
+
+// GradientsConfig lists the gradient directions and the color stop
+// templates applied to every color
+type GradientsConfig struct {
+	Gradients struct {
+		Directions []struct {
+			Name        string `yaml:"name"`
+			CSSProperty string `yaml:"css_property"`
+		} `yaml:"directions"`
+		Stops []struct {
+			Name        string `yaml:"name"`
+			Prefix      string `yaml:"prefix"`
+			CSSProperty string `yaml:"css_property"`
+		} `yaml:"stops"`
+	} `yaml:"gradients"`
+}
+
+// MotionConfig lists the transition, timing and animation utilities
+type MotionConfig struct {
+	Motion struct {
+		Transitions []struct {
+			Name        string `yaml:"name"`
+			CSSProperty string `yaml:"css_property"`
+		} `yaml:"transitions"`
+		Duration struct {
+			Scale       []int  `yaml:"scale"`
+			CSSProperty string `yaml:"css_property"`
+		} `yaml:"duration"`
+		Delay struct {
+			Scale       []int  `yaml:"scale"`
+			CSSProperty string `yaml:"css_property"`
+		} `yaml:"delay"`
+		Ease []struct {
+			Name        string `yaml:"name"`
+			CSSProperty string `yaml:"css_property"`
+		} `yaml:"ease"`
+		Animations []struct {
+			Name        string `yaml:"name"`
+			CSSProperty string `yaml:"css_property"`
+			Keyframes   struct {
+				Name   string `yaml:"name"`
+				Frames string `yaml:"frames"`
+			} `yaml:"keyframes"`
+		} `yaml:"animations"`
+	} `yaml:"motion"`
+}
+
+// TransformScale is a scale of transform values and the utilities that
+// apply them
+type TransformScale struct {
+	Values     []namedValue `yaml:"values"`
+	Properties []struct {
+		Name        string `yaml:"name"`
+		Prefix      string `yaml:"prefix"`
+		CSSProperty string `yaml:"css_property"`
+		Negative    bool   `yaml:"negative"`
+	} `yaml:"properties"`
+}
+
+// TransformsConfig lists the composable transform utilities
+type TransformsConfig struct {
+	Transforms struct {
+		Reset     string         `yaml:"reset"`
+		Transform string         `yaml:"transform"`
+		Scale     TransformScale `yaml:"scale"`
+		Rotate    TransformScale `yaml:"rotate"`
+		Skew      TransformScale `yaml:"skew"`
+		Translate TransformScale `yaml:"translate"`
+		Origin    []struct {
+			Name        string `yaml:"name"`
+			CSSProperty string `yaml:"css_property"`
+		} `yaml:"origin"`
+	} `yaml:"transforms"`
+}
+
 // LoadConfig loads and parses all configuration files
 func LoadConfig() (*SpacingConfig, *ColorsConfig, *LayoutConfig, *TypographyConfig, *BordersConfig, *SizingConfig, *PositionConfig, *EffectsConfig, error) {
+	return loadConfig(currentTheme())
+}
+
+// loadConfig loads and parses all configuration files with theme applied
+func loadConfig(theme *Theme) (*SpacingConfig, *ColorsConfig, *LayoutConfig, *TypographyConfig, *BordersConfig, *SizingConfig, *PositionConfig, *EffectsConfig, error) {
 	var spacing SpacingConfig
 	var colors ColorsConfig
 	var layout LayoutConfig
@@ -301,16 +470,30 @@ func LoadConfig() (*SpacingConfig, *ColorsConfig, *LayoutConfig, *TypographyConf
 	}
 
 	for _, config := range configs {
-		data, err := configFS.ReadFile(config.filename)
-		if err != nil {
-			return nil, nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("failed to read %s: %w", config.filename, err)
-		}
-
-		if err := yaml.Unmarshal(data, config.target); err != nil {
-			return nil, nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("failed to parse %s: %w", config.filename, err)
+		if err := loadConfigFile(theme, config.filename, config.target); err != nil {
+			return nil, nil, nil, nil, nil, nil, nil, nil, err
 		}
 	}
 
 	return &spacing, &colors, &layout, &typography, &borders, &sizing, &position, &effects, nil
 }
 
+// loadConfigFile reads an embedded config file, applies theme and parses it
+// into target
+func loadConfigFile(theme *Theme, filename string, target any) error {
+	data, err := configFS.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", filename, err)
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", filename, err)
+	}
+	if err := theme.apply(filename, &doc); err != nil {
+		return fmt.Errorf("failed to apply theme: %w", err)
+	}
+	if err := doc.Decode(target); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", filename, err)
+	}
+	return nil
+}
@@ -2,17 +2,40 @@ package internal
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 )
 
 type Stylesheet struct {
 	rules map[string]string
+	// classes maps an unescaped class name to its properties; the selector
+	// is escaped when the stylesheet is written
+	classes map[string]string
+	// selectors maps a class name to the template of its selector, for
+	// classes that style other elements than their own
+	selectors map[string]string
+	// variables holds the custom properties written in the :root block
+	variables map[string]string
+	// keyframes maps an animation name to the frames of its @keyframes rule
+	keyframes map[string]string
+	media     map[string]*mediaBlock
+}
+
+// mediaBlock holds the rules of one @media at-rule
+type mediaBlock struct {
+	order int
+	rules map[string]string
 }
 
 func NewStylesheet() *Stylesheet {
 	return &Stylesheet{
-		rules: make(map[string]string),
+		rules:     make(map[string]string),
+		classes:   make(map[string]string),
+		selectors: make(map[string]string),
+		variables: make(map[string]string),
+		keyframes: make(map[string]string),
+		media:     make(map[string]*mediaBlock),
 	}
 }
 
@@ -20,26 +43,136 @@ func (s *Stylesheet) AddRule(selector, properties string) {
 	s.rules[selector] = properties
 }
 
+// AddClassRule adds a rule for a single class. The name is the readable
+// class attribute value, such as "w-1/2"; it is escaped for the selector.
+func (s *Stylesheet) AddClassRule(className, properties string) {
+	s.classes[className] = properties
+}
+
+// AddVariable defines a custom property such as "--color-red-500" in the
+// :root block, which is written before all other rules
+func (s *Stylesheet) AddVariable(name, value string) {
+	s.variables[name] = value
+}
+
+// AddKeyframes adds a @keyframes rule, such as "spin" with the frames
+// "to { transform: rotate(360deg) }". Each name is written once, after the
+// top-level rules.
+func (s *Stylesheet) AddKeyframes(name, frames string) {
+	s.keyframes[name] = frames
+}
+
+// AddSelectorRule adds a rule for a single class whose selector is built
+// from a template such as "{class} > * + *", where {class} is the class
+// selector. An empty template is the class selector itself.
+func (s *Stylesheet) AddSelectorRule(className, selector, properties string) {
+	s.classes[className] = properties
+	if selector != "" && selector != classPlaceholder {
+		s.selectors[className] = selector
+	} else {
+		delete(s.selectors, className)
+	}
+}
+
+// classPlaceholder stands for the class selector in selector templates
+const classPlaceholder = "{class}"
+
+// expandSelector replaces the class placeholder of a selector template
+func expandSelector(template, classSelector string) string {
+	if template == "" {
+		return classSelector
+	}
+	return strings.ReplaceAll(template, classPlaceholder, classSelector)
+}
+
+// ClassSelector returns the selector matching the class name
+func ClassSelector(className string) string {
+	return "." + EscapeClassName(className)
+}
+
+// AddMediaRule adds a rule inside the @media block for query. Media blocks
+// are emitted after all top-level rules, ordered by ascending order and then
+// by query, so larger breakpoints override smaller ones.
+func (s *Stylesheet) AddMediaRule(query string, order int, selector, properties string) {
+	block, ok := s.media[query]
+	if !ok {
+		block = &mediaBlock{order: order, rules: make(map[string]string)}
+		s.media[query] = block
+	}
+	block.rules[selector] = properties
+}
+
 func (s *Stylesheet) GenerateCSS() string {
-	if len(s.rules) == 0 {
+	if len(s.rules) == 0 && len(s.classes) == 0 && len(s.variables) == 0 && len(s.keyframes) == 0 && len(s.media) == 0 {
 		return ""
 	}
 
 	var css strings.Builder
-	
-	// Sort selectors for consistent output
-	selectors := make([]string, 0, len(s.rules))
-	for selector := range s.rules {
+	if len(s.variables) > 0 {
+		names := make([]string, 0, len(s.variables))
+		for name := range s.variables {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		declarations := make([]string, len(names))
+		for i, name := range names {
+			declarations[i] = name + ": " + s.variables[name]
+		}
+		css.WriteString(fmt.Sprintf(":root { %s }\n", strings.Join(declarations, "; ")))
+	}
+
+	rules := make(map[string]string, len(s.rules)+len(s.classes))
+	for selector, properties := range s.rules {
+		rules[selector] = properties
+	}
+	for className, properties := range s.classes {
+		rules[expandSelector(s.selectors[className], ClassSelector(className))] = properties
+	}
+
+	writeRules(&css, rules, "")
+
+	names := make([]string, 0, len(s.keyframes))
+	for name := range s.keyframes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		css.WriteString(fmt.Sprintf("@keyframes %s { %s }\n", name, s.keyframes[name]))
+	}
+
+	queries := make([]string, 0, len(s.media))
+	for query := range s.media {
+		queries = append(queries, query)
+	}
+	sort.Slice(queries, func(i, j int) bool {
+		a, b := s.media[queries[i]], s.media[queries[j]]
+		if a.order != b.order {
+			return a.order < b.order
+		}
+		return queries[i] < queries[j]
+	})
+
+	for _, query := range queries {
+		css.WriteString(fmt.Sprintf("@media %s {\n", query))
+		writeRules(&css, s.media[query].rules, "  ")
+		css.WriteString("}\n")
+	}
+
+	return css.String()
+}
+
+// writeRules writes rules sorted by selector for consistent output
+func writeRules(css *strings.Builder, rules map[string]string, indent string) {
+	selectors := make([]string, 0, len(rules))
+	for selector := range rules {
 		selectors = append(selectors, selector)
 	}
 	sort.Strings(selectors)
 
 	for _, selector := range selectors {
-		properties := s.rules[selector]
-		css.WriteString(fmt.Sprintf("%s { %s }\n", selector, properties))
+		properties := rules[selector]
+		css.WriteString(fmt.Sprintf("%s%s { %s }\n", indent, selector, properties))
 	}
-
-	return css.String()
 }
 
 // GenerateUtilities creates CSS rules using the new config-driven approach
@@ -54,11 +187,15 @@ func GenerateUtilities() *Stylesheet {
 
 // GenerateUtilitiesFromConfig creates CSS rules from config files
 func GenerateUtilitiesFromConfig() (*Stylesheet, error) {
-	spacing, colors, layout, typography, borders, sizing, position, effects, err := LoadConfig()
+	c, err := loadUtilityConfig(currentTheme())
 	if err != nil {
 		return nil, err
 	}
+	return generateStylesheet(c)
+}
 
+// generateStylesheet creates the CSS rules of the utilities in c
+func generateStylesheet(c *utilityConfig) (*Stylesheet, error) {
 	s := NewStylesheet()
 
 	// Add base/reset styles for proper typography
@@ -81,288 +218,70 @@ func GenerateUtilitiesFromConfig() (*Stylesheet, error) {
 	s.AddRule("pre", "font-family: ui-monospace, SFMono-Regular, 'SF Mono', Consolas, 'Liberation Mono', Menlo, monospace; font-size: 0.875rem; line-height: 1.5rem; background-color: #f3f4f6; padding: 1rem; border-radius: 0.375rem; overflow-x: auto")
 	s.AddRule("pre code", "background-color: transparent; padding: 0")
 
-	// Generate spacing utilities
-	for _, prop := range spacing.Spacing.Properties {
-		for _, size := range spacing.Spacing.Scale {
-			value := float64(size) * spacing.Spacing.RemMultiplier
-			className := fmt.Sprintf(".%s-%d", prop.Prefix, size)
-			
-			cssValue := strings.ReplaceAll(prop.CSSProperty, "{value}", fmt.Sprintf("%.2frem", value))
-			s.AddRule(className, cssValue)
-		}
-	}
-
-	// Generate color utilities
-	for colorName, shades := range colors.Colors {
-		for shade, hex := range shades {
-			bgClass := fmt.Sprintf(".bg-%s-%s", colorName, shade)
-			textClass := fmt.Sprintf(".text-%s-%s", colorName, shade)
-			
-			s.AddRule(bgClass, fmt.Sprintf("background-color: %s", hex))
-			s.AddRule(textClass, fmt.Sprintf("color: %s", hex))
-		}
-	}
-
-	// Generate layout utilities
-	for _, display := range layout.Layout.Display {
-		s.AddRule(fmt.Sprintf(".%s", display.Name), display.CSSProperty)
-	}
-
-	// Generate flexbox utilities
-	for _, justify := range layout.Flexbox.Justify {
-		s.AddRule(fmt.Sprintf(".%s", justify.Name), justify.CSSProperty)
-	}
-	for _, align := range layout.Flexbox.Align {
-		s.AddRule(fmt.Sprintf(".%s", align.Name), align.CSSProperty)
-	}
-	for _, direction := range layout.Flexbox.Direction {
-		s.AddRule(fmt.Sprintf(".%s", direction.Name), direction.CSSProperty)
-	}
-	for _, wrap := range layout.Flexbox.Wrap {
-		s.AddRule(fmt.Sprintf(".%s", wrap.Name), wrap.CSSProperty)
-	}
-
-	// Generate grid utilities
-	for _, cols := range layout.Grid.Cols.Scale {
-		className := fmt.Sprintf(".grid-cols-%d", cols)
-		cssValue := strings.ReplaceAll(layout.Grid.Cols.CSSTemplate, "{value}", fmt.Sprintf("%d", cols))
-		s.AddRule(className, cssValue)
-	}
-	for _, rows := range layout.Grid.Rows.Scale {
-		className := fmt.Sprintf(".grid-rows-%d", rows)
-		cssValue := strings.ReplaceAll(layout.Grid.Rows.CSSTemplate, "{value}", fmt.Sprintf("%d", rows))
-		s.AddRule(className, cssValue)
-	}
-	for _, gap := range layout.Grid.Gap.Scale {
-		value := float64(gap) * layout.Grid.Gap.RemMultiplier
-		className := fmt.Sprintf(".gap-%d", gap)
-		cssValue := strings.ReplaceAll(layout.Grid.Gap.CSSTemplate, "{value}", fmt.Sprintf("%.2f", value))
-		s.AddRule(className, cssValue)
-	}
-
-	// Generate typography utilities
-	for sizeName, sizeConfig := range typography.Typography.Sizes {
-		className := fmt.Sprintf(".text-%s", sizeName)
-		cssValue := fmt.Sprintf("font-size: %s; line-height: %s", sizeConfig.Size, sizeConfig.LineHeight)
-		s.AddRule(className, cssValue)
-	}
-	for _, family := range typography.Typography.Families {
-		s.AddRule(fmt.Sprintf(".%s", family.Name), family.CSSProperty)
-	}
-	for _, align := range typography.Typography.Align {
-		s.AddRule(fmt.Sprintf(".%s", align.Name), align.CSSProperty)
-	}
-	for _, weight := range typography.Typography.Weight {
-		s.AddRule(fmt.Sprintf(".%s", weight.Name), weight.CSSProperty)
-	}
-	for _, decoration := range typography.Typography.Decoration {
-		s.AddRule(fmt.Sprintf(".%s", decoration.Name), decoration.CSSProperty)
-	}
-
-	// Generate border utilities
-	for _, prop := range borders.Borders.Width.Properties {
-		for _, width := range borders.Borders.Width.Scale {
-			className := fmt.Sprintf(".%s-%d", prop.Prefix, width)
-			cssValue := strings.ReplaceAll(prop.CSSProperty, "{value}", fmt.Sprintf("%d", width))
-			s.AddRule(className, cssValue)
+	// Generate the utility rules; utilities.go has a function for each
+	// family
+	for _, f := range newUtilitySet(s, c).families {
+		for _, value := range f.values {
+			s.AddSelectorRule(f.class(value.name), f.selector, value.properties)
 		}
 	}
-	for _, prop := range borders.Borders.Radius.Properties {
-		for _, radius := range borders.Borders.Radius.Scale {
-			value := float64(radius) * borders.Borders.Radius.RemMultiplier
-			className := fmt.Sprintf(".%s-%d", prop.Prefix, radius)
-			cssValue := strings.ReplaceAll(prop.CSSProperty, "{value}", fmt.Sprintf("%.2f", value))
-			s.AddRule(className, cssValue)
+	for _, animation := range c.motion.Motion.Animations {
+		if animation.Keyframes.Name != "" {
+			s.AddKeyframes(animation.Keyframes.Name, animation.Keyframes.Frames)
 		}
 	}
-	for _, special := range borders.Borders.Radius.Special {
-		s.AddRule(fmt.Sprintf(".%s", special.Name), special.CSSProperty)
-	}
-	for _, style := range borders.Borders.Style {
-		s.AddRule(fmt.Sprintf(".%s", style.Name), style.CSSProperty)
-	}
 
-	// Generate sizing utilities
-	// Width
-	for _, size := range sizing.Sizing.Width.Scale {
-		s.AddRule(fmt.Sprintf(".w-%s", size.Name), fmt.Sprintf("width: %s", size.Value))
-	}
-	for _, size := range sizing.Sizing.Width.Special {
-		s.AddRule(fmt.Sprintf(".w-%s", size.Name), fmt.Sprintf("width: %s", size.Value))
-	}
-	for _, size := range sizing.Sizing.Width.Fractions {
-		s.AddRule(fmt.Sprintf(".w-%s", size.Name), fmt.Sprintf("width: %s", size.Value))
-	}
-	
-	// Height
-	for _, size := range sizing.Sizing.Height.Scale {
-		s.AddRule(fmt.Sprintf(".h-%s", size.Name), fmt.Sprintf("height: %s", size.Value))
-	}
-	for _, size := range sizing.Sizing.Height.Special {
-		s.AddRule(fmt.Sprintf(".h-%s", size.Name), fmt.Sprintf("height: %s", size.Value))
-	}
-	for _, size := range sizing.Sizing.Height.Fractions {
-		s.AddRule(fmt.Sprintf(".h-%s", size.Name), fmt.Sprintf("height: %s", size.Value))
-	}
-	
-	// Max width
-	for _, size := range sizing.Sizing.MaxWidth.Values {
-		s.AddRule(fmt.Sprintf(".max-w-%s", size.Name), fmt.Sprintf("max-width: %s", size.Value))
-	}
-	
-	// Min width
-	for _, size := range sizing.Sizing.MinWidth.Values {
-		s.AddRule(fmt.Sprintf(".min-w-%s", size.Name), fmt.Sprintf("min-width: %s", size.Value))
-	}
-	
-	// Max height
-	for _, size := range sizing.Sizing.MaxHeight.Scale {
-		s.AddRule(fmt.Sprintf(".max-h-%s", size.Name), fmt.Sprintf("max-height: %s", size.Value))
-	}
-	for _, size := range sizing.Sizing.MaxHeight.Special {
-		s.AddRule(fmt.Sprintf(".max-h-%s", size.Name), fmt.Sprintf("max-height: %s", size.Value))
-	}
-	
-	// Min height
-	for _, size := range sizing.Sizing.MinHeight.Values {
-		s.AddRule(fmt.Sprintf(".min-h-%s", size.Name), fmt.Sprintf("min-height: %s", size.Value))
-	}
-
-	// Generate position utilities
-	for _, pos := range position.Position.Types {
-		s.AddRule(fmt.Sprintf(".%s", pos.Name), pos.CSSProperty)
-	}
-	
-	// Inset utilities (top, right, bottom, left)
-	directions := []string{"top", "right", "bottom", "left"}
-	for _, dir := range directions {
-		// Regular scale
-		for _, inset := range position.Position.Inset.Scale {
-			s.AddRule(fmt.Sprintf(".%s-%s", dir, inset.Name), fmt.Sprintf("%s: %s", dir, inset.Value))
-		}
-		// Special values
-		for _, inset := range position.Position.Inset.Special {
-			s.AddRule(fmt.Sprintf(".%s-%s", dir, inset.Name), fmt.Sprintf("%s: %s", dir, inset.Value))
-		}
-		// Negative scale
-		for _, inset := range position.Position.Inset.NegativeScale {
-			s.AddRule(fmt.Sprintf(".-%s%s", dir, inset.Name), fmt.Sprintf("%s: %s", dir, inset.Value))
-		}
-		// Negative special
-		for _, inset := range position.Position.Inset.NegativeSpecial {
-			s.AddRule(fmt.Sprintf(".-%s%s", dir, inset.Name), fmt.Sprintf("%s: %s", dir, inset.Value))
-		}
-	}
-	
-	// Inset (all sides)
-	for _, inset := range position.Position.Inset.Scale {
-		s.AddRule(fmt.Sprintf(".inset-%s", inset.Name), fmt.Sprintf("inset: %s", inset.Value))
-	}
-	for _, inset := range position.Position.Inset.Special {
-		s.AddRule(fmt.Sprintf(".inset-%s", inset.Name), fmt.Sprintf("inset: %s", inset.Value))
-	}
-	
-	// Inset X and Y
-	for _, inset := range position.Position.Inset.Scale {
-		s.AddRule(fmt.Sprintf(".inset-x-%s", inset.Name), fmt.Sprintf("left: %s; right: %s", inset.Value, inset.Value))
-		s.AddRule(fmt.Sprintf(".inset-y-%s", inset.Name), fmt.Sprintf("top: %s; bottom: %s", inset.Value, inset.Value))
-	}
-	for _, inset := range position.Position.Inset.Special {
-		s.AddRule(fmt.Sprintf(".inset-x-%s", inset.Name), fmt.Sprintf("left: %s; right: %s", inset.Value, inset.Value))
-		s.AddRule(fmt.Sprintf(".inset-y-%s", inset.Name), fmt.Sprintf("top: %s; bottom: %s", inset.Value, inset.Value))
-	}
-	
-	// Z-index
-	for _, z := range position.Position.ZIndex.Values {
-		s.AddRule(fmt.Sprintf(".z-%s", z.Name), fmt.Sprintf("z-index: %s", z.Value))
-	}
-	for _, z := range position.Position.ZIndex.NegativeValues {
-		s.AddRule(fmt.Sprintf(".-z%s", z.Name), fmt.Sprintf("z-index: %s", z.Value))
-	}
-	
-	// Overflow
-	for _, overflow := range position.Position.Overflow.Types {
-		s.AddRule(fmt.Sprintf(".%s", overflow.Name), overflow.CSSProperty)
-	}
+	// Every element resets the variables that transforms, rings and
+	// filters compose
+	resets := append([]string{c.transforms.Transforms.Reset, c.borders.Borders.Ring.Reset}, filterResets(c.effects)...)
+	s.AddRule(resetSelector, strings.Join(resets, "; "))
 
-	// Generate effects utilities
-	// Opacity
-	for _, opacity := range effects.Effects.Opacity.Values {
-		s.AddRule(fmt.Sprintf(".opacity-%s", opacity.Name), fmt.Sprintf("opacity: %s", opacity.Value))
-	}
-	
-	// Shadow
-	for _, shadow := range effects.Effects.Shadow.Values {
-		className := ".shadow"
-		if shadow.Name != "" {
-			className = fmt.Sprintf(".shadow-%s", shadow.Name)
-		}
-		s.AddRule(className, fmt.Sprintf("box-shadow: %s", shadow.Value))
-	}
-	
-	// Cursor
-	for _, cursor := range effects.Effects.Cursor.Values {
-		s.AddRule(fmt.Sprintf(".%s", cursor.Name), cursor.CSSProperty)
-	}
-	
-	// User select
-	for _, userSelect := range effects.Effects.UserSelect.Values {
-		s.AddRule(fmt.Sprintf(".%s", userSelect.Name), userSelect.CSSProperty)
-	}
-	
-	// Pointer events
-	for _, pointerEvents := range effects.Effects.PointerEvents.Values {
-		s.AddRule(fmt.Sprintf(".%s", pointerEvents.Name), pointerEvents.CSSProperty)
-	}
-	
-	// Visibility
-	for _, visibility := range effects.Effects.Visibility.Values {
-		s.AddRule(fmt.Sprintf(".%s", visibility.Name), visibility.CSSProperty)
-	}
-	
-	// Screen readers
-	for _, sr := range effects.Effects.ScreenReaders.Values {
-		s.AddRule(fmt.Sprintf(".%s", sr.Name), sr.CSSProperty)
+	if err := s.checkClassRules(); err != nil {
+		return nil, err
 	}
-
 	return s, nil
 }
 
-// GenerateMinimalCSS creates CSS rules only for the specified classes
+// GenerateMinimalCSS creates CSS rules only for the specified classes.
+// Rules are looked up in an index built once from the config, so the cost
+// is proportional to the number of classes rather than the size of the
+// full utility set.
 func GenerateMinimalCSS(usedClasses []string) *Stylesheet {
 	if len(usedClasses) == 0 {
 		return NewStylesheet()
 	}
 	
-	// Convert slice to map for faster lookup
-	usedClassMap := make(map[string]bool)
-	for _, class := range usedClasses {
-		usedClassMap[class] = true
-	}
-	
-	// Generate full stylesheet
-	fullStylesheet, err := GenerateUtilitiesFromConfig()
+	idx, err := loadIndex()
 	if err != nil {
 		return generateBasicUtilities()
 	}
 	
-	// Create minimal stylesheet with only used classes
 	minimalStylesheet := NewStylesheet()
 	
 	// Always include base styles (non-class selectors)
-	for selector, properties := range fullStylesheet.rules {
-		if !strings.HasPrefix(selector, ".") {
-			// This is a base style (element selector), always include it
-			minimalStylesheet.AddRule(selector, properties)
-		} else {
-			// This is a class selector, only include if used
-			className := strings.TrimPrefix(selector, ".")
-			if usedClassMap[className] {
-				minimalStylesheet.AddRule(selector, properties)
-			}
+	for selector, properties := range idx.base {
+		minimalStylesheet.AddRule(selector, properties)
+	}
+	
+	// Add a rule for each used class that has one
+	usesDark := false
+	for _, className := range usedClasses {
+		idx.addClassRules(minimalStylesheet, className)
+		if variants, _ := splitVariants(className); slices.Contains(variants, darkVariant) {
+			usesDark = true
 		}
 	}
 	
+	// Pages that use dark utilities also get dark base styles
+	if usesDark {
+		idx.addDarkBaseRules(minimalStylesheet)
+	}
+
+	// Reset the composed variables the chosen rules use, and define only
+	// the custom properties they reference
+	idx.addResets(minimalStylesheet)
+	idx.addVariables(minimalStylesheet)
+	
 	return minimalStylesheet
 }
 
@@ -373,14 +292,14 @@ func generateBasicUtilities() *Stylesheet {
 	// Basic spacing utilities (0-16)
 	for i := 0; i <= 16; i++ {
 		rem := float64(i) * 0.25
-		s.AddRule(fmt.Sprintf(".p-%d", i), fmt.Sprintf("padding: %.2frem", rem))
-		s.AddRule(fmt.Sprintf(".m-%d", i), fmt.Sprintf("margin: %.2frem", rem))
+		s.AddClassRule(fmt.Sprintf("p-%d", i), fmt.Sprintf("padding: %.2frem", rem))
+		s.AddClassRule(fmt.Sprintf("m-%d", i), fmt.Sprintf("margin: %.2frem", rem))
 	}
 
 	// Basic layout utilities
-	s.AddRule(".flex", "display: flex")
-	s.AddRule(".block", "display: block")
-	s.AddRule(".hidden", "display: none")
+	s.AddClassRule("flex", "display: flex")
+	s.AddClassRule("block", "display: block")
+	s.AddClassRule("hidden", "display: none")
 	
 	return s
 }
\ No newline at end of file
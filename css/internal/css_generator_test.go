@@ -1,7 +1,13 @@
 package internal_test
 
 import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/computesdk/zforge/css/internal"
@@ -106,4 +112,725 @@ func TestStylesheetSpecialCharacters(t *testing.T) {
 	assert.Contains(t, css, ".class\\:hover")
 	assert.Contains(t, css, "#id-with-dash")
 	assert.Contains(t, css, "[data-attr]")
-}
\ No newline at end of file
+}
+func TestGenerateMinimalCSSMatchesFullStylesheet(t *testing.T) {
+	full := internal.GenerateUtilities().GenerateCSS()
+
+	minimal := internal.GenerateMinimalCSS([]string{"p-4", "bg-blue-500", "flex", "not-a-utility"}).GenerateCSS()
+
+	// Every rule in the minimal stylesheet comes from the full one
+	for _, line := range strings.Split(strings.TrimSpace(minimal), "\n") {
+		assert.Contains(t, full, line)
+	}
+	assert.Contains(t, minimal, ".p-4 {")
+	assert.Contains(t, minimal, ".bg-blue-500 {")
+	assert.Contains(t, minimal, ".flex {")
+	assert.Contains(t, minimal, "body {")
+	assert.NotContains(t, minimal, "not-a-utility")
+}
+
+func TestGenerateMinimalCSSConcurrent(t *testing.T) {
+	expected := internal.GenerateMinimalCSS([]string{"p-4", "m-2"}).GenerateCSS()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, expected, internal.GenerateMinimalCSS([]string{"p-4", "m-2"}).GenerateCSS())
+		}()
+	}
+	wg.Wait()
+}
+
+// usedClasses returns n distinct class names that all have rules
+func usedClasses(n int) []string {
+	classes := make([]string, 0, n)
+	prefixes := []string{"p", "m", "px", "py", "mt", "mb", "ml", "mr", "pt", "pb"}
+	sizes := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 14, 16, 20, 24, 28, 32, 36}
+	for _, prefix := range prefixes {
+		for _, size := range sizes {
+			if len(classes) == n {
+				return classes
+			}
+			classes = append(classes, fmt.Sprintf("%s-%d", prefix, size))
+		}
+	}
+	return classes
+}
+
+func BenchmarkGenerateMinimalCSS(b *testing.B) {
+	// Build the index outside the measured loop
+	internal.GenerateMinimalCSS([]string{"p-4"})
+
+	for _, n := range []int{1, 10, 100} {
+		classes := usedClasses(n)
+		b.Run(fmt.Sprintf("classes=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				internal.GenerateMinimalCSS(classes)
+			}
+		})
+	}
+}
+
+func BenchmarkGenerateUtilitiesFromConfig(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		internal.GenerateUtilitiesFromConfig()
+	}
+}
+
+func TestEscapeClassName(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"p-4", "p-4"},
+		{"hover:bg-blue-600", `hover\:bg-blue-600`},
+		{"w-1/2", `w-1\/2`},
+		{"w-0.5", `w-0\.5`},
+		{"w-[37px]", `w-\[37px\]`},
+		{"2xl", `\32 xl`},
+		{"-z10", "-z10"},
+		{"-1", `-\31 `},
+		{"-", `\-`},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, internal.EscapeClassName(tt.name), "escaping %s", tt.name)
+	}
+}
+
+func TestStylesheetAddClassRule(t *testing.T) {
+	s := internal.NewStylesheet()
+	s.AddClassRule("w-1/2", "width: 50%")
+	s.AddRule("a", "color: blue")
+
+	css := s.GenerateCSS()
+	assert.Contains(t, css, `.w-1\/2 { width: 50% }`)
+	assert.Contains(t, css, "a { color: blue }")
+}
+
+func TestGenerateUtilitiesEscapesSelectors(t *testing.T) {
+	full := internal.GenerateUtilities().GenerateCSS()
+	assert.Contains(t, full, `.w-1\/2 { width: 50% }`)
+	assert.Contains(t, full, `.w-0\.5 { width: 0.125rem }`)
+	assert.NotContains(t, full, ".w-1/2 ")
+
+	minimal := internal.GenerateMinimalCSS([]string{"w-1/2", "h-0.5", "md:w-1/2"}).GenerateCSS()
+	assert.Contains(t, minimal, `.w-1\/2 { width: 50% }`)
+	assert.Contains(t, minimal, `.h-0\.5 { height: 0.125rem }`)
+	assert.Contains(t, minimal, `.md\:w-1\/2 { width: 50% }`)
+}
+
+func TestGenerateMinimalCSSStateVariants(t *testing.T) {
+	css := internal.GenerateMinimalCSS([]string{
+		"hover:bg-blue-600",
+		"focus:hover:p-4",
+		"focus-visible:flex",
+		"hover:not-a-utility",
+		"unknown:p-2",
+	}).GenerateCSS()
+
+	assert.Contains(t, css, `.hover\:bg-blue-600:hover { background-color: #2563eb }`)
+	assert.Contains(t, css, `.focus\:hover\:p-4:hover:focus { padding: 1.00rem }`)
+	assert.Contains(t, css, `.focus-visible\:flex:focus-visible { display: flex }`)
+	assert.NotContains(t, css, "not-a-utility")
+	assert.NotContains(t, css, "unknown")
+}
+
+func TestStylesheetMediaRules(t *testing.T) {
+	s := internal.NewStylesheet()
+
+	s.AddMediaRule("(min-width: 1024px)", 2, ".b", "color: blue")
+	s.AddMediaRule("(min-width: 768px)", 1, ".a", "color: red")
+	s.AddRule(".base", "margin: 0")
+
+	expected := ".base { margin: 0 }\n" +
+		"@media (min-width: 768px) {\n  .a { color: red }\n}\n" +
+		"@media (min-width: 1024px) {\n  .b { color: blue }\n}\n"
+	assert.Equal(t, expected, s.GenerateCSS())
+}
+
+func TestGenerateMinimalCSSBreakpointVariants(t *testing.T) {
+	css := internal.GenerateMinimalCSS([]string{"lg:p-4", "md:grid", "sm:hover:bg-blue-600", "p-2", "xs:flex"}).GenerateCSS()
+
+	assert.Contains(t, css, "@media (min-width: 640px) {\n  .sm\\:hover\\:bg-blue-600:hover { background-color: #2563eb }\n}")
+	assert.Contains(t, css, "@media (min-width: 768px) {\n  .md\\:grid { display: grid }\n}")
+	assert.Contains(t, css, "@media (min-width: 1024px) {\n  .lg\\:p-4 { padding: 1.00rem }\n}")
+	assert.NotContains(t, css, "xs")
+
+	// Base rules come first, then breakpoints from smallest to largest
+	base := strings.Index(css, ".p-2 {")
+	sm := strings.Index(css, "@media (min-width: 640px)")
+	md := strings.Index(css, "@media (min-width: 768px)")
+	lg := strings.Index(css, "@media (min-width: 1024px)")
+	assert.True(t, base < sm && sm < md && md < lg, "unexpected rule order:\n%s", css)
+}
+
+func TestGenerateMinimalCSSDarkMode(t *testing.T) {
+	// Without dark utilities no dark base styles are added
+	css := internal.GenerateMinimalCSS([]string{"p-4"}).GenerateCSS()
+	assert.NotContains(t, css, "prefers-color-scheme")
+
+	css = internal.GenerateMinimalCSS([]string{"dark:bg-gray-900", "md:dark:p-4"}).GenerateCSS()
+	assert.Contains(t, css, "@media (prefers-color-scheme: dark) {\n  .dark\\:bg-gray-900 { background-color: #111827 }\n  a { color: #60a5fa }\n")
+	assert.Contains(t, css, "@media (prefers-color-scheme: dark) and (min-width: 768px) {\n  .md\\:dark\\:p-4 { padding: 1.00rem }\n}")
+	assert.Contains(t, css, "color-scheme: dark")
+}
+
+func TestGenerateMinimalCSSDarkModeSelector(t *testing.T) {
+	assert.NoError(t, internal.SetDarkMode(internal.DarkMode{Strategy: internal.DarkModeSelector, Selector: "[data-theme=dark]"}))
+	defer internal.SetDarkMode(internal.DarkMode{Strategy: internal.DarkModeMedia})
+
+	css := internal.GenerateMinimalCSS([]string{"dark:hover:bg-gray-900"}).GenerateCSS()
+	assert.Contains(t, css, `[data-theme=dark] .dark\:hover\:bg-gray-900:hover { background-color: #111827 }`)
+	assert.Contains(t, css, "[data-theme=dark] body, body[data-theme=dark] {")
+	assert.NotContains(t, css, "prefers-color-scheme")
+}
+
+func TestSetDarkModeValidation(t *testing.T) {
+	assert.Error(t, internal.SetDarkMode(internal.DarkMode{Strategy: "class"}))
+	assert.Error(t, internal.SetDarkMode(internal.DarkMode{Strategy: internal.DarkModeSelector}))
+	assert.Error(t, internal.SetDarkMode(internal.DarkMode{Strategy: internal.DarkModeSelector, Selector: ".dark { }"}))
+}
+
+func TestValidateArbitraryValue(t *testing.T) {
+	valid := []string{"37px", "#1da1f2", "calc(100%_-_1rem)", "200px_1fr", "var(--gap)", "url(a.png)", "url('a.png')", `"a)"`}
+	for _, value := range valid {
+		assert.NoError(t, internal.ValidateArbitraryValue(value), "value %q", value)
+	}
+
+	invalid := []string{"", "1px;color:red", "1px}", "{", "1px 2px", "a\\7d", "calc(1px", "a)", "[a", "</style>", "1px/*", "*/a", "url('x)", `"a`, `'a"`}
+	for _, value := range invalid {
+		assert.Error(t, internal.ValidateArbitraryValue(value), "value %q", value)
+	}
+}
+
+func TestGenerateMinimalCSSArbitraryValues(t *testing.T) {
+	css := internal.GenerateMinimalCSS([]string{
+		"w-[37px]",
+		"bg-[#1da1f2]",
+		"hover:bg-[#0c85d0]",
+		"grid-cols-[200px_1fr]",
+		"w-[1px;color:red]",
+		"unknown-[1px]",
+	}).GenerateCSS()
+
+	assert.Contains(t, css, `.w-\[37px\] { width: 37px }`)
+	assert.Contains(t, css, `.bg-\[\#1da1f2\] { background-color: #1da1f2 }`)
+	assert.Contains(t, css, `.hover\:bg-\[\#0c85d0\]:hover { background-color: #0c85d0 }`)
+	assert.Contains(t, css, `.grid-cols-\[200px_1fr\] { grid-template-columns: 200px 1fr }`)
+	assert.NotContains(t, css, "color:red")
+	assert.NotContains(t, css, "unknown")
+}
+
+func TestValidateClass(t *testing.T) {
+	valid := []string{"p-4", "w-1/2", "flex", "md:hover:bg-blue-600", "dark:text-gray-100", "grid-cols-[200px_1fr]", "bg-black/50"}
+	for _, className := range valid {
+		assert.NoError(t, internal.ValidateClass(className), "class %q", className)
+	}
+
+	tests := []struct {
+		className string
+		message   string
+	}{
+		{"p-13", `unknown utility "p-13"; valid values for p- are 0, 1, 2, 3`},
+		{"not-a-utility", `unknown utility "not-a-utility"`},
+		{"xs:p-4", `unknown variant "xs" in "xs:p-4"`},
+		{"hover:", `empty utility in "hover:"`},
+		{"foo-[1px]", `utility "foo" does not accept arbitrary values`},
+		{"w-[1px;color:red]", `contains ';'`},
+		{"bg-red-500/33", `unknown opacity "33" in "bg-red-500/33"; valid values are 0, 5, 10`},
+	}
+	for _, tt := range tests {
+		err := internal.ValidateClass(tt.className)
+		if assert.Error(t, err, "class %q", tt.className) {
+			assert.Contains(t, err.Error(), tt.message)
+		}
+	}
+}
+
+const testTheme = `
+extend:
+  colors:
+    brand:
+      500: "#ff0066"
+  spacing.scale: [13]
+  typography.families:
+    - name: font-brand
+      css_property: "font-family: Inter, sans-serif"
+    - name: font-mono
+      css_property: "font-family: 'JetBrains Mono', monospace"
+replace:
+  breakpoints:
+    - name: tablet
+      min_width: "700px"
+`
+
+func setTestTheme(t *testing.T, data string) {
+	t.Helper()
+	theme, err := internal.ParseTheme([]byte(data))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	assert.NoError(t, internal.SetTheme(theme))
+	t.Cleanup(func() { internal.SetTheme(nil) })
+}
+
+func TestThemeExtendAndReplace(t *testing.T) {
+	setTestTheme(t, testTheme)
+
+	css := internal.GenerateMinimalCSS([]string{"bg-brand-500", "p-13", "p-4", "font-brand", "font-mono", "tablet:flex", "md:flex"}).GenerateCSS()
+	assert.Contains(t, css, ".bg-brand-500 { background-color: #ff0066 }")
+	assert.Contains(t, css, ".p-13 { padding: 3.25rem }")
+	assert.Contains(t, css, ".p-4 { padding: 1.00rem }")
+	assert.Contains(t, css, ".font-brand { font-family: Inter, sans-serif }")
+	assert.Contains(t, css, ".font-mono { font-family: 'JetBrains Mono', monospace }")
+	assert.Contains(t, css, "@media (min-width: 700px) {\n  .tablet\\:flex { display: flex }\n}")
+	assert.NotContains(t, css, "md\\:flex")
+
+	internal.SetTheme(nil)
+	css = internal.GenerateMinimalCSS([]string{"bg-brand-500", "md:flex"}).GenerateCSS()
+	assert.NotContains(t, css, "brand")
+	assert.Contains(t, css, "md\\:flex")
+}
+
+func TestParseThemeValidation(t *testing.T) {
+	tests := []struct {
+		theme   string
+		message string
+	}{
+		{"extnd: {}", "field extnd not found"},
+		{"extend:\n  colours: {}", `unknown config section "colours"`},
+		{"extend:\n  spacing.scal: [13]", "field scal not found"},
+		{"extend:\n  spacing.scale: [big]", "cannot unmarshal"},
+		{"replace:\n  breakpoints:\n    - name: tablet\n      width: 700px", "field width not found"},
+		{"extend: [colors]", "expected a mapping"},
+	}
+	for _, tt := range tests {
+		_, err := internal.ParseTheme([]byte(tt.theme))
+		if assert.Error(t, err, "theme %q", tt.theme) {
+			assert.Contains(t, err.Error(), tt.message)
+		}
+	}
+}
+
+func TestGenerateThemeCode(t *testing.T) {
+	base, err := internal.GenerateUtilitiesCode()
+	assert.NoError(t, err)
+	setTestTheme(t, testTheme)
+	themed, err := internal.GenerateUtilitiesCode()
+	assert.NoError(t, err)
+
+	code, err := internal.GenerateThemeCode(base, themed, "ui", "theme.yaml")
+	assert.NoError(t, err)
+	assert.Contains(t, code, "package ui")
+	assert.Contains(t, code, "//go:embed theme.yaml")
+	assert.Contains(t, code, "// Spacing13 is 13 on the Spacing scale\nfunc Spacing13() css.Spacing {\n\treturn css.ThemeValue[css.Spacing](\"13\")")
+	assert.Contains(t, code, "func Translate13() css.Translation {\n\treturn css.ThemeValue[css.Translation](\"13\")")
+	// The values of every type come before the functions
+	assert.Less(t, strings.Index(code, "func Translate13("), strings.Index(code, "func BgBrand("))
+	assert.Contains(t, code, "func BgBrand(shade css.Shade) css.Class {")
+	assert.Contains(t, code, `className := "bg-brand-" + shade.String()`)
+	assert.Contains(t, code, "css.Register(className)")
+	assert.Contains(t, code, "func FontBrand() css.Class {")
+	assert.Contains(t, code, "func Tablet(class css.Class) css.Class {\n\treturn css.Variant(\"tablet\", class)")
+	assert.NotContains(t, code, "func P(")
+	assert.NotContains(t, code, "Spacing4(")
+}
+
+func TestStylesheetVariables(t *testing.T) {
+	s := internal.NewStylesheet()
+	s.AddClassRule("p-4", "padding: var(--spacing-4)")
+	s.AddVariable("--spacing-4", "1.00rem")
+	s.AddVariable("--color-red-500", "#ef4444")
+
+	css := s.GenerateCSS()
+	assert.True(t, strings.HasPrefix(css, ":root { --color-red-500: #ef4444; --spacing-4: 1.00rem }\n"), css)
+	assert.Contains(t, css, ".p-4 { padding: var(--spacing-4) }")
+}
+
+func TestCustomProperties(t *testing.T) {
+	internal.SetCustomProperties(true)
+	defer internal.SetCustomProperties(false)
+
+	css := internal.GenerateMinimalCSS([]string{"bg-red-500", "hover:text-red-500", "p-4", "md:mx-2", "flex"}).GenerateCSS()
+	assert.Contains(t, css, ":root { --color-red-500: #ef4444; --spacing-2: 0.50rem; --spacing-4: 1.00rem }")
+	assert.Contains(t, css, ".bg-red-500 { background-color: var(--color-red-500) }")
+	assert.Contains(t, css, `.hover\:text-red-500:hover { color: var(--color-red-500) }`)
+	assert.Contains(t, css, ".p-4 { padding: var(--spacing-4) }")
+	assert.Contains(t, css, "margin-left: var(--spacing-2)")
+	assert.NotContains(t, css, "--color-blue-500")
+
+	// Gaps share the spacing scale
+	css = internal.GenerateMinimalCSS([]string{"gap-4"}).GenerateCSS()
+	assert.Contains(t, css, ":root { --spacing-4: 1.00rem }")
+	assert.Contains(t, css, ".gap-4 { gap: var(--spacing-4) }")
+
+	// Stylesheets without color or spacing utilities get no :root block
+	assert.NotContains(t, internal.GenerateMinimalCSS([]string{"flex"}).GenerateCSS(), ":root")
+}
+
+func TestCustomPropertiesDisabled(t *testing.T) {
+	css := internal.GenerateMinimalCSS([]string{"bg-red-500", "p-4"}).GenerateCSS()
+	assert.Contains(t, css, ".bg-red-500 { background-color: #ef4444 }")
+	assert.NotContains(t, css, "var(--")
+}
+
+func TestOpacityModifiers(t *testing.T) {
+	css := internal.GenerateMinimalCSS([]string{"bg-red-500/50", "text-blue-500/75", "hover:bg-red-500/5", "bg-red-500/33", "p-4/50"}).GenerateCSS()
+	assert.Contains(t, css, `.bg-red-500\/50 { background-color: rgb(239 68 68 / 0.5) }`)
+	assert.Contains(t, css, `.text-blue-500\/75 { color: rgb(59 130 246 / 0.75) }`)
+	assert.Contains(t, css, `.hover\:bg-red-500\/5:hover { background-color: rgb(239 68 68 / 0.05) }`)
+	assert.NotContains(t, css, "/33")
+	assert.NotContains(t, css, "p-4")
+
+	internal.SetCustomProperties(true)
+	defer internal.SetCustomProperties(false)
+	css = internal.GenerateMinimalCSS([]string{"bg-red-500/50"}).GenerateCSS()
+	assert.Contains(t, css, ":root { --color-red-500: #ef4444 }")
+	assert.Contains(t, css, `.bg-red-500\/50 { background-color: color-mix(in srgb, var(--color-red-500) 50%, transparent) }`)
+}
+
+func TestGradientUtilities(t *testing.T) {
+	css := internal.GenerateMinimalCSS([]string{"bg-gradient-to-r", "from-indigo-500", "via-purple-500", "to-pink-500"}).GenerateCSS()
+	assert.Contains(t, css, ".bg-gradient-to-r { background-image: linear-gradient(to right, var(--gradient-stops)) }")
+	assert.Contains(t, css, ".from-indigo-500 { --gradient-from: #6366f1; --gradient-to: rgb(99 102 241 / 0); --gradient-stops: var(--gradient-from), var(--gradient-to) }")
+	assert.Contains(t, css, ".via-purple-500 { --gradient-stops: var(--gradient-from), #a855f7, var(--gradient-to) }")
+	assert.Contains(t, css, ".to-pink-500 { --gradient-to: #ec4899 }")
+
+	// Stops that override the from- stop come after it
+	assert.Less(t, strings.Index(css, ".from-indigo-500"), strings.Index(css, ".to-pink-500"))
+	assert.Less(t, strings.Index(css, ".from-indigo-500"), strings.Index(css, ".via-purple-500"))
+}
+
+func TestStylesheetKeyframes(t *testing.T) {
+	s := internal.NewStylesheet()
+	s.AddClassRule("animate-spin", "animation: spin 1s linear infinite")
+	s.AddKeyframes("spin", "to { transform: rotate(360deg) }")
+	s.AddKeyframes("spin", "to { transform: rotate(360deg) }")
+	s.AddMediaRule("(min-width: 768px)", 1, ".md\\:flex", "display: flex")
+
+	css := s.GenerateCSS()
+	assert.Equal(t, 1, strings.Count(css, "@keyframes spin { to { transform: rotate(360deg) } }\n"))
+	assert.Less(t, strings.Index(css, ".animate-spin"), strings.Index(css, "@keyframes"))
+	assert.Less(t, strings.Index(css, "@keyframes"), strings.Index(css, "@media"))
+}
+
+func TestMotionUtilities(t *testing.T) {
+	css := internal.GenerateMinimalCSS([]string{
+		"transition",
+		"duration-200",
+		"delay-75",
+		"ease-in-out",
+		"animate-spin",
+		"hover:animate-spin",
+		"md:animate-pulse",
+	}).GenerateCSS()
+
+	assert.Contains(t, css, ".transition { transition-property: color, background-color")
+	assert.Contains(t, css, ".duration-200 { transition-duration: 200ms }")
+	assert.Contains(t, css, ".delay-75 { transition-delay: 75ms }")
+	assert.Contains(t, css, ".ease-in-out { transition-timing-function: cubic-bezier(0.4, 0, 0.2, 1) }")
+	assert.Contains(t, css, ".animate-spin { animation: spin 1s linear infinite }")
+	assert.Equal(t, 1, strings.Count(css, "@keyframes spin "))
+	assert.Equal(t, 1, strings.Count(css, "@keyframes pulse "))
+	assert.NotContains(t, css, "@keyframes bounce")
+
+	// Keyframes are only emitted for animations that are used
+	assert.NotContains(t, internal.GenerateMinimalCSS([]string{"transition"}).GenerateCSS(), "@keyframes")
+}
+
+func TestTransformUtilities(t *testing.T) {
+	css := internal.GenerateMinimalCSS([]string{"rotate-45", "hover:scale-110", "-translate-x-1/2", "translate-y-4", "origin-top-left"}).GenerateCSS()
+
+	transform := "transform: translate(var(--translate-x), var(--translate-y)) rotate(var(--rotate)) skewX(var(--skew-x)) skewY(var(--skew-y)) scaleX(var(--scale-x)) scaleY(var(--scale-y))"
+	assert.Contains(t, css, ".rotate-45 { --rotate: 45deg; "+transform+" }")
+	assert.Contains(t, css, `.hover\:scale-110:hover { --scale-x: 1.1; --scale-y: 1.1; `+transform+" }")
+	assert.Contains(t, css, `.-translate-x-1\/2 { --translate-x: -50%; `+transform+" }")
+	assert.Contains(t, css, ".translate-y-4 { --translate-y: 1.00rem; "+transform+" }")
+	assert.Contains(t, css, ".origin-top-left { transform-origin: top left }")
+	assert.Contains(t, css, "*, ::before, ::after { --translate-x: 0; --translate-y: 0; --rotate: 0;")
+
+	// The reset is only added to stylesheets that use a transform
+	assert.NotContains(t, internal.GenerateMinimalCSS([]string{"origin-top-left", "flex"}).GenerateCSS(), "::before")
+}
+
+func TestTransformCustomProperties(t *testing.T) {
+	internal.SetCustomProperties(true)
+	defer internal.SetCustomProperties(false)
+
+	css := internal.GenerateMinimalCSS([]string{"-translate-y-4"}).GenerateCSS()
+	assert.Contains(t, css, ":root { --spacing-4: 1.00rem }")
+	assert.Contains(t, css, ".-translate-y-4 { --translate-y: calc(var(--spacing-4) * -1); ")
+}
+
+func TestFilterUtilities(t *testing.T) {
+	css := internal.GenerateMinimalCSS([]string{"blur", "grayscale", "hover:grayscale-0", "drop-shadow-lg", "backdrop-blur-md", "backdrop-brightness-150"}).GenerateCSS()
+
+	filter := "filter: var(--blur,) var(--brightness,) var(--contrast,) var(--grayscale,) var(--drop-shadow,)"
+	backdrop := "backdrop-filter: var(--backdrop-blur,) var(--backdrop-brightness,) var(--backdrop-contrast,) var(--backdrop-grayscale,)"
+	assert.Contains(t, css, ".blur { --blur: blur(8px); "+filter+" }")
+	assert.Contains(t, css, ".grayscale { --grayscale: grayscale(100%); "+filter+" }")
+	assert.Contains(t, css, `.hover\:grayscale-0:hover { --grayscale: grayscale(0); `+filter+" }")
+	assert.Contains(t, css, ".drop-shadow-lg { --drop-shadow: drop-shadow(0 10px 8px rgb(0 0 0 / 0.04)) drop-shadow(0 4px 3px rgb(0 0 0 / 0.1)); "+filter+" }")
+	assert.Contains(t, css, ".backdrop-blur-md { --backdrop-blur: blur(12px); "+backdrop+" }")
+	assert.Contains(t, css, ".backdrop-brightness-150 { --backdrop-brightness: brightness(1.5); "+backdrop+" }")
+
+	// Only the variables of the used families are reset
+	assert.Contains(t, css, "*, ::before, ::after { --blur: initial; --backdrop-blur: initial;")
+	assert.Contains(t, css, "--backdrop-grayscale: initial")
+	assert.NotContains(t, css, "--rotate")
+	assert.NotContains(t, internal.GenerateMinimalCSS([]string{"rotate-45"}).GenerateCSS(), "--blur")
+}
+
+func TestStylesheetAddSelectorRule(t *testing.T) {
+	s := internal.NewStylesheet()
+	s.AddSelectorRule("divide-y", "{class} > * + *", "border-top-width: 1px")
+	s.AddSelectorRule("p-4", "", "padding: 1rem")
+	s.AddSelectorRule("w-1/2", "{class}", "width: 50%")
+
+	css := s.GenerateCSS()
+	assert.Contains(t, css, ".divide-y > * + * { border-top-width: 1px }")
+	assert.Contains(t, css, ".p-4 { padding: 1rem }")
+	assert.Contains(t, css, `.w-1\/2 { width: 50% }`)
+}
+
+func TestBorderColorUtilities(t *testing.T) {
+	css := internal.GenerateMinimalCSS([]string{
+		"border-gray-200",
+		"border-t-red-500",
+		"hover:border-blue-500/50",
+		"divide-y",
+		"divide-gray-200",
+		"md:divide-x-2",
+		"divide-red-500/50",
+		"outline-none",
+		"outline-blue-500",
+		"outline-offset-2",
+	}).GenerateCSS()
+
+	divide := " > :not([hidden]) ~ :not([hidden])"
+	assert.Contains(t, css, ".border-gray-200 { border-color: #e5e7eb }")
+	assert.Contains(t, css, ".border-t-red-500 { border-top-color: #ef4444 }")
+	assert.Contains(t, css, `.hover\:border-blue-500\/50:hover { border-color: rgb(59 130 246 / 0.5) }`)
+	assert.Contains(t, css, ".divide-y"+divide+" { border-top-width: 1px; border-bottom-width: 0 }")
+	assert.Contains(t, css, ".divide-gray-200"+divide+" { border-color: #e5e7eb }")
+	assert.Contains(t, css, `  .md\:divide-x-2`+divide+" { border-right-width: 0; border-left-width: 2px }")
+	assert.Contains(t, css, `.divide-red-500\/50`+divide+" { border-color: rgb(239 68 68 / 0.5) }")
+	assert.Contains(t, css, ".outline-none { outline: 2px solid transparent; outline-offset: 2px }")
+	assert.Contains(t, css, ".outline-blue-500 { outline-color: #3b82f6 }")
+	assert.Contains(t, css, ".outline-offset-2 { outline-offset: 2px }")
+	assert.NotContains(t, css, "::before")
+}
+
+func TestRingUtilities(t *testing.T) {
+	css := internal.GenerateMinimalCSS([]string{"focus:ring-2", "ring", "ring-blue-500", "ring-offset-2", "ring-offset-white"}).GenerateCSS()
+
+	assert.Contains(t, css, `.focus\:ring-2:focus { --ring-offset-shadow: var(--ring-inset,) 0 0 0 var(--ring-offset-width) var(--ring-offset-color); --ring-shadow: var(--ring-inset,) 0 0 0 calc(2px + var(--ring-offset-width)) var(--ring-color); box-shadow: var(--ring-offset-shadow), var(--ring-shadow) }`)
+	assert.Contains(t, css, "calc(3px + var(--ring-offset-width))")
+	assert.Contains(t, css, ".ring-blue-500 { --ring-color: #3b82f6 }")
+	assert.Contains(t, css, ".ring-offset-2 { --ring-offset-width: 2px }")
+	assert.Contains(t, css, ".ring-offset-white { --ring-offset-color: #ffffff }")
+	assert.Contains(t, css, "*, ::before, ::after { --ring-inset: initial; --ring-offset-width: 0px; --ring-offset-color: #fff; --ring-color: rgb(59 130 246 / 0.5) }")
+}
+
+func TestSpaceUtilities(t *testing.T) {
+	css := internal.GenerateMinimalCSS([]string{"space-x-4", "md:space-y-2", "hover:space-x-1"}).GenerateCSS()
+
+	children := " > :not([hidden]) ~ :not([hidden])"
+	assert.Contains(t, css, ".space-x-4"+children+" { margin-left: 1.00rem }")
+	assert.Contains(t, css, `  .md\:space-y-2`+children+" { margin-top: 0.50rem }")
+	assert.Contains(t, css, `.hover\:space-x-1:hover`+children+" { margin-left: 0.25rem }")
+	assert.NotContains(t, css, "&")
+}
+
+func TestSelectorTemplateList(t *testing.T) {
+	setTestTheme(t, `
+extend:
+  spacing.properties:
+    - name: gutter
+      prefix: gutter
+      selector: "{class}::before, {class}::after"
+      css_property: "width: {value}"
+`)
+	assert.NoError(t, internal.SetDarkMode(internal.DarkMode{Strategy: internal.DarkModeSelector, Selector: ".dark"}))
+	defer internal.SetDarkMode(internal.DarkMode{Strategy: internal.DarkModeMedia})
+
+	css := internal.GenerateMinimalCSS([]string{"gutter-2", "dark:gutter-4"}).GenerateCSS()
+	assert.Contains(t, css, ".gutter-2::before, .gutter-2::after { width: 0.50rem }")
+	assert.Contains(t, css, `.dark .dark\:gutter-4::before, .dark .dark\:gutter-4::after { width: 1.00rem }`)
+}
+
+func TestSelectorTemplateValidation(t *testing.T) {
+	tests := []struct {
+		property string
+		message  string
+	}{
+		{`selector: "> * + *"`, "has no {class} placeholder"},
+		{`css_property: "& > * + * { margin-left: {value} }"`, "not flat declarations"},
+	}
+	t.Cleanup(func() { internal.SetTheme(nil) })
+	for _, tt := range tests {
+		theme, err := internal.ParseTheme([]byte("extend:\n  spacing.properties:\n    - name: gutter\n      prefix: gutter\n      " + tt.property + "\n"))
+		if !assert.NoError(t, err) {
+			continue
+		}
+		err = internal.SetTheme(theme)
+		if assert.Error(t, err, "property %q", tt.property) {
+			assert.Contains(t, err.Error(), tt.message)
+		}
+	}
+}
+
+func TestRejectedThemeIsNeverVisible(t *testing.T) {
+	theme, err := internal.ParseTheme([]byte("extend:\n  spacing.properties:\n    - name: gutter\n      prefix: gutter\n      selector: \"> * + *\"\n"))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 10; i++ {
+			assert.Error(t, internal.SetTheme(theme))
+		}
+	}()
+	for {
+		select {
+		case <-done:
+			return
+		default:
+		}
+		spacing, _, _, _, _, _, _, _, err := internal.LoadConfig()
+		if !assert.NoError(t, err) {
+			return
+		}
+		for _, property := range spacing.Spacing.Properties {
+			if property.Prefix == "gutter" {
+				t.Fatal("LoadConfig returned the rejected theme")
+			}
+		}
+	}
+}
+
+func TestEveryUtilityHasFunction(t *testing.T) {
+	code, err := internal.GenerateUtilitiesCode()
+	if !assert.NoError(t, err) {
+		return
+	}
+	generated := generatedClasses(t, code)
+	assert.True(t, generated["p-4"])
+	assert.False(t, generated["p-13"])
+
+	stylesheet, err := internal.GenerateUtilitiesFromConfig()
+	if !assert.NoError(t, err) {
+		return
+	}
+	classes := cssClasses(stylesheet.GenerateCSS())
+	assert.Greater(t, len(classes), 5000)
+	for _, class := range classes {
+		assert.True(t, generated[class], "no function returns %q", class)
+	}
+}
+
+// generatedClasses returns every class a generated function can return: a
+// constant, the default of an optional argument, or a prefix followed by
+// one of the values of the parameter type, such as "p-" + size.String()
+func generatedClasses(t *testing.T, code string) map[string]bool {
+	file, err := parser.ParseFile(token.NewFileSet(), "utilities.go", code, 0)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	// The values of each value type, such as Spacing{"4"}
+	values := make(map[string][]string)
+	ast.Inspect(file, func(n ast.Node) bool {
+		if lit, ok := n.(*ast.CompositeLit); ok && len(lit.Elts) == 1 {
+			typeName, isIdent := lit.Type.(*ast.Ident)
+			if value, isString := stringLiteral(lit.Elts[0]); isIdent && isString {
+				values[typeName.Name] = append(values[typeName.Name], value)
+			}
+		}
+		return true
+	})
+
+	classes := make(map[string]bool)
+	for _, decl := range file.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok || fd.Recv != nil || fd.Body == nil {
+			continue
+		}
+		var param string
+		if params := fd.Type.Params.List; len(params) == 1 {
+			paramType := params[0].Type
+			if ellipsis, ok := paramType.(*ast.Ellipsis); ok {
+				paramType = ellipsis.Elt
+			}
+			if ident, ok := paramType.(*ast.Ident); ok {
+				param = ident.Name
+			}
+		}
+		ast.Inspect(fd.Body, func(n ast.Node) bool {
+			var results []ast.Expr
+			switch n := n.(type) {
+			case *ast.ReturnStmt:
+				results = n.Results
+			case *ast.AssignStmt:
+				results = n.Rhs
+			}
+			for _, result := range results {
+				if class, ok := stringLiteral(result); ok {
+					classes[class] = true
+				}
+				if concat, ok := result.(*ast.BinaryExpr); ok && concat.Op == token.ADD {
+					if prefix, ok := stringLiteral(concat.X); ok {
+						for _, value := range values[param] {
+							classes[prefix+value] = true
+						}
+					}
+				}
+			}
+			return true
+		})
+	}
+	return classes
+}
+
+// stringLiteral returns the value of a string literal
+func stringLiteral(expr ast.Expr) (string, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	value, err := strconv.Unquote(lit.Value)
+	return value, err == nil
+}
+
+// cssClasses returns the unescaped class names of the selectors in css
+func cssClasses(css string) []string {
+	var classes []string
+	for _, line := range strings.Split(css, "\n") {
+		selector, _, ok := strings.Cut(strings.TrimSpace(line), " {")
+		if !ok || !strings.HasPrefix(selector, ".") {
+			continue
+		}
+		var class strings.Builder
+		for i := 1; i < len(selector); i++ {
+			c := selector[i]
+			if c == '\\' && i+1 < len(selector) {
+				i++
+				class.WriteByte(selector[i])
+				continue
+			}
+			if strings.IndexByte(" :.,[>", c) >= 0 {
+				break
+			}
+			class.WriteByte(c)
+		}
+		classes = append(classes, class.String())
+	}
+	return classes
+}
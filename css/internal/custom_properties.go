@@ -0,0 +1,122 @@
+package internal
+
+import (
+	"regexp"
+	"strings"
+	"sync/atomic"
+)
+
+// customProperties makes color and spacing utilities reference CSS custom
+// properties defined in a :root block instead of literal values
+var customProperties atomic.Bool
+
+// SetCustomProperties enables or disables custom property output for all
+// subsequent stylesheets
+func SetCustomProperties(enabled bool) {
+	indexMu.Lock()
+	defer indexMu.Unlock()
+	customProperties.Store(enabled)
+	active.Store(&themeIndex{theme: currentTheme()})
+}
+
+// themeValue returns a reference to the custom property name after
+// defining it on the stylesheet, or value itself if custom properties are
+// disabled
+func themeValue(s *Stylesheet, name, value string) string {
+	if !customProperties.Load() {
+		return value
+	}
+	s.AddVariable(name, value)
+	return "var(" + name + ")"
+}
+
+// varPattern matches custom property references in rule properties
+var varPattern = regexp.MustCompile(`var\((--[A-Za-z0-9_-]+)`)
+
+// addVariables defines the custom properties referenced by the rules of s
+func (idx *utilityIndex) addVariables(s *Stylesheet) {
+	if len(idx.variables) == 0 {
+		return
+	}
+	for name := range s.variableRefs() {
+		if value, ok := idx.variables[name]; ok {
+			s.AddVariable(name, value)
+		}
+	}
+}
+
+// resetSelector matches every element, so the variables that transform,
+// ring and filter utilities compose start from their defaults instead of
+// being inherited
+const resetSelector = "*, ::before, ::after"
+
+// filterResets returns the declarations resetting the variables of the
+// filter and backdrop filter utilities
+func filterResets(effects *EffectsConfig) []string {
+	var resets []string
+	for _, fn := range effects.Effects.Filters.Functions {
+		resets = append(resets, "--"+fn.Name+": initial")
+		if fn.Backdrop {
+			resets = append(resets, "--backdrop-"+fn.Name+": initial")
+		}
+	}
+	return resets
+}
+
+// variableReset is one declaration of the reset rule
+type variableReset struct {
+	variable    string
+	declaration string
+}
+
+// parseResets splits the reset rule into its declarations
+func parseResets(properties string) []variableReset {
+	var resets []variableReset
+	for _, declaration := range strings.Split(properties, ";") {
+		declaration = strings.TrimSpace(declaration)
+		if variable, _, ok := strings.Cut(declaration, ":"); ok {
+			resets = append(resets, variableReset{variable: strings.TrimSpace(variable), declaration: declaration})
+		}
+	}
+	return resets
+}
+
+// addResets adds a reset rule with the declarations of the composed
+// variables that the rules of s reference
+func (idx *utilityIndex) addResets(s *Stylesheet) {
+	if len(idx.resets) == 0 {
+		return
+	}
+	refs := s.variableRefs()
+	var declarations []string
+	for _, reset := range idx.resets {
+		if refs[reset.variable] {
+			declarations = append(declarations, reset.declaration)
+		}
+	}
+	if len(declarations) > 0 {
+		s.AddRule(resetSelector, strings.Join(declarations, "; "))
+	}
+}
+
+// variableRefs returns the custom properties referenced by the rules of s
+func (s *Stylesheet) variableRefs() map[string]bool {
+	refs := make(map[string]bool)
+	add := func(properties string) {
+		for _, match := range varPattern.FindAllStringSubmatch(properties, -1) {
+			refs[match[1]] = true
+		}
+	}
+	for _, properties := range s.rules {
+		add(properties)
+	}
+	for _, properties := range s.classes {
+		add(properties)
+	}
+	for _, block := range s.media {
+		for _, properties := range block.rules {
+			add(properties)
+		}
+	}
+	return refs
+}
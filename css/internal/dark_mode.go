@@ -0,0 +1,87 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+// Dark mode strategies
+const (
+	// DarkModeMedia applies dark utilities when the user prefers a dark
+	// color scheme
+	DarkModeMedia = "media"
+	// DarkModeSelector applies dark utilities under an ancestor matching
+	// the configured selector, such as ".dark" or "[data-theme=dark]"
+	DarkModeSelector = "selector"
+)
+
+// darkVariant is the variant prefix of dark mode utilities
+const darkVariant = "dark"
+
+// DarkMode configures how the dark variant is applied
+type DarkMode struct {
+	Strategy string `yaml:"strategy"`
+	Selector string `yaml:"selector"`
+}
+
+// darkModeOverride replaces the configured dark mode when set
+var darkModeOverride atomic.Pointer[DarkMode]
+
+// SetDarkMode replaces the dark mode from the config for all subsequent
+// stylesheets
+func SetDarkMode(mode DarkMode) error {
+	switch mode.Strategy {
+	case DarkModeMedia:
+	case DarkModeSelector:
+		if strings.TrimSpace(mode.Selector) == "" || strings.ContainsAny(mode.Selector, "{};,") {
+			return fmt.Errorf("invalid dark mode selector %q", mode.Selector)
+		}
+	default:
+		return fmt.Errorf("unknown dark mode strategy %q", mode.Strategy)
+	}
+	darkModeOverride.Store(&mode)
+	return nil
+}
+
+// darkMode returns the dark mode in effect
+func (idx *utilityIndex) darkMode() DarkMode {
+	if mode := darkModeOverride.Load(); mode != nil {
+		return *mode
+	}
+	return idx.dark
+}
+
+// darkBaseRules are the dark counterparts of the base styles. They are only
+// added to stylesheets that use the dark variant, so pages without a dark
+// theme are unaffected by the user's color scheme preference.
+var darkBaseRules = map[string]string{
+	"body":    "color: #f9fafb; background-color: #030712; color-scheme: dark",
+	"a":       "color: #60a5fa",
+	"a:hover": "color: #93c5fd",
+	"code":    "background-color: #1f2937",
+	"pre":     "background-color: #1f2937",
+}
+
+// addDarkBaseRules adds the dark base styles using the current dark mode
+func (idx *utilityIndex) addDarkBaseRules(s *Stylesheet) {
+	mode := idx.darkMode()
+	for selector, properties := range darkBaseRules {
+		if mode.Strategy == DarkModeSelector {
+			// The ancestor may be set on body itself
+			darkSelector := mode.Selector + " " + selector
+			if selector == "body" {
+				darkSelector += ", body" + mode.Selector
+			}
+			s.AddRule(darkSelector, properties)
+		} else {
+			s.AddMediaRule(darkMediaCondition, darkMediaOrder, selector, properties)
+		}
+	}
+}
+
+const (
+	darkMediaCondition = "(prefers-color-scheme: dark)"
+	// darkMediaOrder places dark media blocks before breakpoint blocks
+	darkMediaOrder = -1
+)
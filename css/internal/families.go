@@ -0,0 +1,489 @@
+package internal
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// family is a group of utilities built by one Go function, such as the
+// padding utilities p-0 to p-96 built by P, or the flex utility built by
+// Flex. The stylesheet and utilities.go are both generated from the
+// families, so every class with a rule has a function that returns it.
+type family struct {
+	// funcName is the name of the Go function
+	funcName string
+	// doc completes the function comment "funcName applies ..."
+	doc string
+	// prefix is the class of the value without a name; the classes of the
+	// other values are prefix-name
+	prefix string
+	// selector is the selector template of the rules, if any
+	selector string
+	// param is the parameter type of the function and arg its name. A
+	// family without named values has no parameter.
+	param string
+	arg   string
+	// values are the classes of the family
+	values []familyValue
+}
+
+// familyValue is one class of a family and its declarations
+type familyValue struct {
+	name       string
+	properties string
+}
+
+// class returns the class name of a value; the value without a name is the
+// bare prefix, such as shadow or blur
+func (f family) class(name string) string {
+	if name == "" {
+		return f.prefix
+	}
+	return f.prefix + "-" + name
+}
+
+// valueType is a named parameter type and its scale
+type valueType struct {
+	name   string
+	doc    string
+	prefix string
+	values []string
+}
+
+// keywordUtility is a config entry for a utility without a value, such as
+// flex or underline
+type keywordUtility = struct {
+	Name        string `yaml:"name"`
+	CSSProperty string `yaml:"css_property"`
+}
+
+// utilityConfig holds the configs the utilities are generated from
+type utilityConfig struct {
+	spacing    *SpacingConfig
+	colors     *ColorsConfig
+	layout     *LayoutConfig
+	typography *TypographyConfig
+	borders    *BordersConfig
+	sizing     *SizingConfig
+	position   *PositionConfig
+	effects    *EffectsConfig
+	gradients  *GradientsConfig
+	motion     *MotionConfig
+	transforms *TransformsConfig
+	// breakpoints and arbitrary are used by the variant and arbitrary value
+	// functions and the index, not by the families
+	breakpoints *BreakpointsConfig
+	arbitrary   *ArbitraryConfig
+}
+
+// loadUtilityConfig loads the configs the utilities are generated from,
+// with theme applied
+func loadUtilityConfig(theme *Theme) (*utilityConfig, error) {
+	spacing, colors, layout, typography, borders, sizing, position, effects, err := loadConfig(theme)
+	if err != nil {
+		return nil, err
+	}
+	c := &utilityConfig{
+		spacing:     spacing,
+		colors:      colors,
+		layout:      layout,
+		typography:  typography,
+		borders:     borders,
+		sizing:      sizing,
+		position:    position,
+		effects:     effects,
+		gradients:   &GradientsConfig{},
+		motion:      &MotionConfig{},
+		transforms:  &TransformsConfig{},
+		breakpoints: &BreakpointsConfig{},
+		arbitrary:   &ArbitraryConfig{},
+	}
+	files := []struct {
+		filename string
+		target   any
+	}{
+		{"config/gradients.yaml", c.gradients},
+		{"config/motion.yaml", c.motion},
+		{"config/transforms.yaml", c.transforms},
+		{"config/breakpoints.yaml", c.breakpoints},
+		{"config/arbitrary.yaml", c.arbitrary},
+	}
+	for _, file := range files {
+		if err := loadConfigFile(theme, file.filename, file.target); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// utilitySet is the shared description of every utility family and the
+// parameter types of their functions
+type utilitySet struct {
+	types    []valueType
+	families []family
+}
+
+func (u *utilitySet) addType(name, doc, prefix string, values []string) {
+	u.types = append(u.types, valueType{name: name, doc: doc, prefix: prefix, values: values})
+}
+
+func (u *utilitySet) add(f family) {
+	u.families = append(u.families, f)
+}
+
+// addKeywords adds a family without a parameter for each keyword utility
+func (u *utilitySet) addKeywords(selector string, keywords ...[]keywordUtility) {
+	for _, list := range keywords {
+		for _, keyword := range list {
+			u.add(family{
+				funcName: toCamelCase(keyword.Name),
+				doc:      keyword.Name + " utility",
+				prefix:   keyword.Name,
+				selector: selector,
+				values:   []familyValue{{properties: keyword.CSSProperty}},
+			})
+		}
+	}
+}
+
+// scaleFamily returns a family with an integer parameter whose values fill
+// {value} in template with format
+func scaleFamily(funcName, doc, prefix, param, arg string, scale []int, template string, format func(int) string) family {
+	f := family{funcName: funcName, doc: doc, prefix: prefix, param: param, arg: arg}
+	for _, value := range scale {
+		f.values = append(f.values, familyValue{name: strconv.Itoa(value), properties: strings.ReplaceAll(template, "{value}", format(value))})
+	}
+	return f
+}
+
+// namedFamily returns a family with a string parameter whose values fill
+// {value} in template
+func namedFamily(funcName, doc, prefix, param, arg, template string, scales ...[]namedValue) family {
+	f := family{funcName: funcName, doc: doc, prefix: prefix, param: param, arg: arg}
+	for _, scale := range scales {
+		for _, value := range scale {
+			f.values = append(f.values, familyValue{name: value.Name, properties: strings.ReplaceAll(template, "{value}", value.Value)})
+		}
+	}
+	return f
+}
+
+// mergeScales returns the values of integer scales in ascending order,
+// without duplicates
+func mergeScales(scales ...[]int) []int {
+	merged := slices.Concat(scales...)
+	slices.Sort(merged)
+	return slices.Compact(merged)
+}
+
+// newUtilitySet describes the utilities of the config. Values that become
+// custom properties are defined on s.
+func newUtilitySet(s *Stylesheet, c *utilityConfig) *utilitySet {
+	u := &utilitySet{}
+	u.addSpacing(s, c.spacing)
+	u.addColors(s, c.colors, c.borders, c.gradients)
+	u.addLayout(s, c.layout)
+	u.addTypography(c.typography)
+	u.addBorders(c.borders)
+	u.addSizing(c.sizing)
+	u.addPosition(c.position)
+	u.addEffects(c.effects)
+	u.addFilters(c.effects)
+	u.addTransforms(s, c.transforms, c.spacing)
+	u.addMotion(c.motion)
+	return u
+}
+
+func (u *utilitySet) addSpacing(s *Stylesheet, spacing *SpacingConfig) {
+	u.addType("Spacing", "is a value on the spacing scale", "Spacing", intNames(spacing.Spacing.Scale))
+	for _, prop := range spacing.Spacing.Properties {
+		f := scaleFamily(toCamelCase(prop.Prefix), prop.Name+" utility", prop.Prefix, "Spacing", "size", spacing.Spacing.Scale, prop.CSSProperty, func(size int) string {
+			return themeValue(s, fmt.Sprintf("--spacing-%d", size), fmt.Sprintf("%.2frem", float64(size)*spacing.Spacing.RemMultiplier))
+		})
+		f.selector = prop.Selector
+		u.add(f)
+	}
+}
+
+// addColors adds the gradient directions and a family for every color
+// utility and gradient stop of every color, such as BgRed or FromIndigo. A
+// color whose only shade is the default one, such as white, has no
+// parameter.
+func (u *utilitySet) addColors(s *Stylesheet, colors *ColorsConfig, borders *BordersConfig, gradients *GradientsConfig) {
+	u.addType("Shade", "is a color shade", "Shade", shadeNames(colors))
+	u.addKeywords("", gradients.Gradients.Directions)
+
+	type colorTemplate struct {
+		funcName, prefix, selector, template string
+		stop                                 bool
+	}
+	var templates []colorTemplate
+	for _, utility := range colorUtilities(borders) {
+		templates = append(templates, colorTemplate{toCamelCase(utility.Prefix), utility.Prefix, utility.Selector, utility.CSSProperty, false})
+	}
+	for _, stop := range gradients.Gradients.Stops {
+		templates = append(templates, colorTemplate{stop.Name, stop.Prefix, "", stop.CSSProperty, true})
+	}
+
+	for _, colorName := range sortedKeys(colors.Colors) {
+		shades := colors.Colors[colorName]
+		for _, t := range templates {
+			f := family{
+				funcName: t.funcName + strings.Title(colorName),
+				prefix:   t.prefix + "-" + colorName,
+				selector: t.selector,
+				param:    "Shade",
+				arg:      "shade",
+			}
+			for _, shade := range sortedKeys(shades) {
+				variable := colorVariable(colorName, shade)
+				color := themeValue(s, variable, shades[shade])
+				replacer := strings.NewReplacer("{color}", color)
+				if t.stop {
+					// Colors that are not hex, such as from a theme, fade
+					// to plain transparent
+					transparent, err := alphaColor(shades[shade], variable, "0", "0")
+					if err != nil {
+						transparent = "transparent"
+					}
+					replacer = strings.NewReplacer("{color}", color, "{transparent}", transparent)
+				}
+				f.values = append(f.values, familyValue{name: shadeName(shade), properties: replacer.Replace(t.template)})
+			}
+			f.doc = f.prefix
+			if _, ok := shades[defaultShade]; !ok || len(shades) > 1 {
+				f.doc += "-shade"
+			}
+			if t.stop {
+				f.doc += " gradient stop"
+			}
+			f.doc += " utility"
+			u.add(f)
+		}
+	}
+}
+
+func (u *utilitySet) addLayout(s *Stylesheet, layout *LayoutConfig) {
+	u.addKeywords("", layout.Layout.Display, layout.Layout.Container)
+	u.addKeywords("", layout.Flexbox.Justify, layout.Flexbox.Align, layout.Flexbox.Direction, layout.Flexbox.Wrap, layout.Flexbox.Grow)
+
+	grid := layout.Grid
+	u.addType("GridTracks", "is a number of grid columns or rows", "Tracks", intNames(mergeScales(grid.Cols.Scale, grid.Rows.Scale)))
+	u.add(scaleFamily("GridCols", "grid-cols utility", "grid-cols", "GridTracks", "count", grid.Cols.Scale, grid.Cols.CSSTemplate, strconv.Itoa))
+	u.add(scaleFamily("GridRows", "grid-rows utility", "grid-rows", "GridTracks", "count", grid.Rows.Scale, grid.Rows.CSSTemplate, strconv.Itoa))
+	u.add(scaleFamily("Gap", "gap utility", "gap", "Spacing", "size", grid.Gap.Scale, grid.Gap.CSSTemplate, func(gap int) string {
+		return themeValue(s, fmt.Sprintf("--spacing-%d", gap), fmt.Sprintf("%.2frem", float64(gap)*grid.Gap.RemMultiplier))
+	}))
+}
+
+func (u *utilitySet) addTypography(typography *TypographyConfig) {
+	for _, sizeName := range sortedKeys(typography.Typography.Sizes) {
+		size := typography.Typography.Sizes[sizeName]
+		funcName := fmt.Sprintf("Text%s", strings.Title(sizeName))
+		if sizeName == "2xl" || sizeName == "3xl" { // Handle special cases
+			funcName = fmt.Sprintf("Text%s", strings.ToUpper(sizeName))
+		}
+		u.add(family{
+			funcName: funcName,
+			doc:      "text-" + sizeName + " utility",
+			prefix:   "text-" + sizeName,
+			values:   []familyValue{{properties: fmt.Sprintf("font-size: %s; line-height: %s", size.Size, size.LineHeight)}},
+		})
+	}
+	u.addKeywords("", typography.Typography.Align, typography.Typography.Weight, typography.Typography.Families, typography.Typography.Decoration)
+}
+
+func (u *utilitySet) addBorders(borders *BordersConfig) {
+	b := borders.Borders
+	u.addType("BorderWidth", "is a value on the border width scale", "BorderWidth", intNames(b.Width.Scale))
+	u.addType("Radius", "is a value on the border radius scale", "Radius", intNames(b.Radius.Scale))
+	for _, prop := range b.Width.Properties {
+		u.add(scaleFamily(toCamelCase(prop.Name), prop.Name+" utility", prop.Prefix, "BorderWidth", "width", b.Width.Scale, prop.CSSProperty, strconv.Itoa))
+	}
+	for _, prop := range b.Radius.Properties {
+		u.add(scaleFamily(toCamelCase(prop.Name), prop.Name+" utility", prop.Prefix, "Radius", "radius", b.Radius.Scale, prop.CSSProperty, func(radius int) string {
+			return fmt.Sprintf("%.2f", float64(radius)*b.Radius.RemMultiplier)
+		}))
+	}
+	u.addKeywords("", b.Radius.Special, b.Style)
+
+	// Divide utilities style the borders between children and are 1px
+	// wide without a width
+	for _, prop := range b.Divide.Properties {
+		f := scaleFamily(toCamelCase(prop.Name), prop.Name+" utility to the borders between children", prop.Prefix, "BorderWidth", "width", b.Width.Scale, prop.CSSProperty, strconv.Itoa)
+		f.selector = b.Divide.Selector
+		f.values = append([]familyValue{{properties: strings.ReplaceAll(prop.CSSProperty, "{value}", "1")}}, f.values...)
+		u.add(f)
+	}
+	u.addKeywords(b.Divide.Selector, b.Divide.Style)
+
+	// Rings have the default width without a width
+	ring, outline := b.Ring, b.Outline
+	u.addType("RingWidth", "is a value on the ring width scale", "RingWidth", intNames(ring.Scale))
+	u.addType("OutlineSize", "is a value on the outline width scale", "OutlineSize", intNames(outline.Scale))
+	f := scaleFamily("Ring", "ring utility", "ring", "RingWidth", "width", ring.Scale, ring.CSSProperty, strconv.Itoa)
+	f.values = append([]familyValue{{properties: strings.ReplaceAll(ring.CSSProperty, "{value}", strconv.Itoa(ring.Default))}}, f.values...)
+	u.add(f)
+	u.add(scaleFamily("RingOffset", "ring-offset utility", "ring-offset", "RingWidth", "width", ring.Scale, ring.OffsetProperty, strconv.Itoa))
+	u.addKeywords("", ring.Special)
+	u.add(scaleFamily("OutlineWidth", "outline width utility", "outline", "OutlineSize", "width", outline.Scale, outline.CSSProperty, strconv.Itoa))
+	u.add(scaleFamily("OutlineOffset", "outline-offset utility", "outline-offset", "OutlineSize", "offset", outline.Scale, outline.OffsetProperty, strconv.Itoa))
+	u.addKeywords("", outline.Style)
+}
+
+func (u *utilitySet) addSizing(sizing *SizingConfig) {
+	sz := sizing.Sizing
+	u.addType("Width", "is a width", "W", valueNames(sz.Width.Scale, sz.Width.Special, sz.Width.Fractions))
+	u.addType("Height", "is a height", "H", valueNames(sz.Height.Scale, sz.Height.Special, sz.Height.Fractions))
+	u.addType("MaxWidth", "is a max-width", "MaxW", valueNames(sz.MaxWidth.Values))
+	u.addType("MinWidth", "is a min-width", "MinW", valueNames(sz.MinWidth.Values))
+	u.addType("MaxHeight", "is a max-height", "MaxH", valueNames(sz.MaxHeight.Scale, sz.MaxHeight.Special))
+	u.addType("MinHeight", "is a min-height", "MinH", valueNames(sz.MinHeight.Values))
+	u.add(namedFamily("W", "width utility", "w", "Width", "size", "width: {value}", sz.Width.Scale, sz.Width.Special, sz.Width.Fractions))
+	u.add(namedFamily("H", "height utility", "h", "Height", "size", "height: {value}", sz.Height.Scale, sz.Height.Special, sz.Height.Fractions))
+	u.add(namedFamily("MaxW", "max-width utility", "max-w", "MaxWidth", "size", "max-width: {value}", sz.MaxWidth.Values))
+	u.add(namedFamily("MinW", "min-width utility", "min-w", "MinWidth", "size", "min-width: {value}", sz.MinWidth.Values))
+	u.add(namedFamily("MaxH", "max-height utility", "max-h", "MaxHeight", "size", "max-height: {value}", sz.MaxHeight.Scale, sz.MaxHeight.Special))
+	u.add(namedFamily("MinH", "min-height utility", "min-h", "MinHeight", "size", "min-height: {value}", sz.MinHeight.Values))
+}
+
+// addPosition adds the position utilities. Negative insets and z-indexes
+// such as -top-1 or -z-10 have Neg functions that take the positive value.
+func (u *utilitySet) addPosition(position *PositionConfig) {
+	p := position.Position
+	u.addType("Offset", "is a top, right, bottom, left or inset value", "Offset", valueNames(p.Inset.Scale, p.Inset.Special))
+	u.addType("ZIndex", "is a z-index", "Z", valueNames(p.ZIndex.Values))
+	u.addKeywords("", p.Types)
+	offsets := [][]namedValue{p.Inset.Scale, p.Inset.Special}
+	negative := positiveNames(p.Inset.NegativeScale, p.Inset.NegativeSpecial)
+	for _, dir := range []string{"top", "right", "bottom", "left"} {
+		u.add(namedFamily(toCamelCase(dir), dir+" position utility", dir, "Offset", "value", dir+": {value}", offsets...))
+		u.add(namedFamily("Neg"+toCamelCase(dir), "negative "+dir+" position utility", "-"+dir, "Offset", "value", dir+": {value}", negative))
+	}
+	u.add(namedFamily("Inset", "inset utility", "inset", "Offset", "value", "inset: {value}", offsets...))
+	u.add(namedFamily("InsetX", "horizontal inset utility", "inset-x", "Offset", "value", "left: {value}; right: {value}", offsets...))
+	u.add(namedFamily("InsetY", "vertical inset utility", "inset-y", "Offset", "value", "top: {value}; bottom: {value}", offsets...))
+	u.add(namedFamily("Z", "z-index utility", "z", "ZIndex", "value", "z-index: {value}", p.ZIndex.Values))
+	u.add(namedFamily("NegZ", "negative z-index utility", "-z", "ZIndex", "value", "z-index: {value}", positiveNames(p.ZIndex.NegativeValues)))
+	u.addKeywords("", p.Overflow.Types)
+}
+
+// positiveNames returns the values of negative scales, named without the
+// leading dash
+func positiveNames(scales ...[]namedValue) []namedValue {
+	var values []namedValue
+	for _, scale := range scales {
+		for _, value := range scale {
+			values = append(values, namedValue{Name: strings.TrimPrefix(value.Name, "-"), Value: value.Value})
+		}
+	}
+	return values
+}
+
+func (u *utilitySet) addEffects(effects *EffectsConfig) {
+	e := effects.Effects
+	u.addType("OpacityLevel", "is a value on the opacity scale", "Opacity", valueNames(e.Opacity.Values))
+	u.addType("ShadowSize", "is a box shadow size", "Shadow", valueNames(e.Shadow.Values))
+	u.add(namedFamily("Opacity", "opacity utility", "opacity", "OpacityLevel", "value", "opacity: {value}", e.Opacity.Values))
+	u.add(namedFamily("Shadow", "shadow utility", "shadow", "ShadowSize", "size", "box-shadow: {value}", e.Shadow.Values))
+	u.addKeywords("", e.Cursor.Values, e.UserSelect.Values, e.PointerEvents.Values, e.Visibility.Values, e.ScreenReaders.Values)
+}
+
+// addFilters adds the filter and backdrop filter utilities. Each sets the
+// variable of its function and the shared filter declaration.
+func (u *utilitySet) addFilters(effects *EffectsConfig) {
+	filters := effects.Effects.Filters
+	for _, fn := range filters.Functions {
+		u.addType(fn.Type, "is a "+fn.Name+" filter value", toCamelCase(fn.Name), valueNames(fn.Values))
+
+		add := func(prefix, declaration string) {
+			f := family{funcName: toCamelCase(prefix), doc: prefix + " filter utility", prefix: prefix, param: fn.Type, arg: "value"}
+			for _, value := range fn.Values {
+				cssValue := strings.ReplaceAll(fn.Template, "{value}", value.Value)
+				f.values = append(f.values, familyValue{name: value.Name, properties: "--" + prefix + ": " + cssValue + "; " + declaration})
+			}
+			u.add(f)
+		}
+		add(fn.Name, filters.Filter)
+		if fn.Backdrop {
+			add("backdrop-"+fn.Name, filters.BackdropFilter)
+		}
+	}
+}
+
+// addTransforms adds the transform utilities, with translate values from
+// the spacing scale. Each sets its variables and the shared transform
+// declaration.
+func (u *utilitySet) addTransforms(s *Stylesheet, transforms *TransformsConfig, spacing *SpacingConfig) {
+	t := transforms.Transforms
+	translate := translateValues(s, spacing, transforms)
+	u.addType("ScaleFactor", "is a scale percentage", "Scale", valueNames(t.Scale.Values))
+	u.addType("Rotation", "is a rotation in degrees", "Rotate", valueNames(t.Rotate.Values))
+	u.addType("SkewAngle", "is a skew angle in degrees", "Skew", valueNames(t.Skew.Values))
+	u.addType("Translation", "is a translate distance from the spacing scale or a fraction", "Translate", valueNames(translate))
+	scales := []struct {
+		scale  TransformScale
+		values []namedValue
+		param  string
+	}{
+		{t.Scale, t.Scale.Values, "ScaleFactor"},
+		{t.Rotate, t.Rotate.Values, "Rotation"},
+		{t.Skew, t.Skew.Values, "SkewAngle"},
+		{t.Translate, translate, "Translation"},
+	}
+	for _, sc := range scales {
+		for _, prop := range sc.scale.Properties {
+			f := family{funcName: prop.Name, doc: prop.Prefix + " transform utility", prefix: prop.Prefix, param: sc.param, arg: "value"}
+			neg := family{funcName: "Neg" + prop.Name, doc: "negative -" + prop.Prefix + " transform utility", prefix: "-" + prop.Prefix, param: sc.param, arg: "value"}
+			for _, value := range sc.values {
+				cssValue := strings.ReplaceAll(prop.CSSProperty, "{value}", value.Value)
+				f.values = append(f.values, familyValue{name: value.Name, properties: cssValue + "; " + t.Transform})
+				if value.Name != "0" {
+					cssValue := strings.ReplaceAll(prop.CSSProperty, "{value}", negate(value.Value))
+					neg.values = append(neg.values, familyValue{name: value.Name, properties: cssValue + "; " + t.Transform})
+				}
+			}
+			u.add(f)
+			if prop.Negative {
+				u.add(neg)
+			}
+		}
+	}
+	u.addKeywords("", t.Origin)
+}
+
+// translateValues returns the translate scale: the spacing scale followed
+// by the values from the transforms config
+func translateValues(s *Stylesheet, spacing *SpacingConfig, transforms *TransformsConfig) []namedValue {
+	var values []namedValue
+	for _, size := range spacing.Spacing.Scale {
+		value := float64(size) * spacing.Spacing.RemMultiplier
+		values = append(values, namedValue{
+			Name:  strconv.Itoa(size),
+			Value: themeValue(s, fmt.Sprintf("--spacing-%d", size), fmt.Sprintf("%.2frem", value)),
+		})
+	}
+	return append(values, transforms.Transforms.Translate.Values...)
+}
+
+// negate returns the negative of a CSS length or angle
+func negate(value string) string {
+	if strings.HasPrefix(value, "var(") {
+		return "calc(" + value + " * -1)"
+	}
+	return "-" + value
+}
+
+func (u *utilitySet) addMotion(motion *MotionConfig) {
+	m := motion.Motion
+	// Duration and delay share one scale type
+	u.addType("Milliseconds", "is a transition time in milliseconds", "Ms", intNames(mergeScales(m.Duration.Scale, m.Delay.Scale)))
+	u.add(scaleFamily("Duration", "transition duration utility", "duration", "Milliseconds", "value", m.Duration.Scale, m.Duration.CSSProperty, strconv.Itoa))
+	u.add(scaleFamily("Delay", "transition delay utility", "delay", "Milliseconds", "value", m.Delay.Scale, m.Delay.CSSProperty, strconv.Itoa))
+	u.addKeywords("", m.Transitions, m.Ease)
+	for _, animation := range m.Animations {
+		u.addKeywords("", []keywordUtility{{Name: animation.Name, CSSProperty: animation.CSSProperty}})
+	}
+}
@@ -2,6 +2,9 @@ package internal
 
 import (
 	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 )
@@ -22,169 +25,72 @@ func (cg *CodeGenerator) AddFunction(funcCode string) {
 	cg.functions = append(cg.functions, funcCode)
 }
 
-// GenerateSpacingFunctions creates functions from spacing config
-func (cg *CodeGenerator) GenerateSpacingFunctions(spacing *SpacingConfig) {
-	for _, prop := range spacing.Spacing.Properties {
-		// Generate function like: func P(size int) Class { return Class(fmt.Sprintf("p-%d", size)) }
-		funcName := toCamelCase(prop.Prefix)
-
-		funcCode := fmt.Sprintf(`// %s applies %s utility
-func %s(size int) Class {
-	className := fmt.Sprintf("%s-%%d", size)
-	trackClass(className)
-	return Class(className)
-}`, prop.Name, prop.Name, funcName, prop.Prefix)
-		cg.AddFunction(funcCode)
-	}
-}
-
-// GenerateColorFunctions creates functions from colors config
-func (cg *CodeGenerator) GenerateColorFunctions(colors *ColorsConfig) {
-	for colorName := range colors.Colors {
-		// Generate BgColorName and TextColorName functions
-		bgFuncName := fmt.Sprintf("Bg%s", strings.Title(colorName))
-		textFuncName := fmt.Sprintf("Text%s", strings.Title(colorName))
-		bgFunc := fmt.Sprintf(`// %s applies bg-%s-shade utility
-func %s(shade int) Class {
-	className := fmt.Sprintf("bg-%s-%%d", shade)
-	trackClass(className)
-	return Class(className)
-}`, bgFuncName, colorName, bgFuncName, colorName)
-
-		textFunc := fmt.Sprintf(`// %s applies text-%s-shade utility  
-func %s(shade int) Class {
-	className := fmt.Sprintf("text-%s-%%d", shade)
-	trackClass(className)
-	return Class(className)
-}`, textFuncName, colorName, textFuncName, colorName)
-
-		cg.AddFunction(bgFunc)
-		cg.AddFunction(textFunc)
-	}
-}
-
-// GenerateLayoutFunctions creates functions from layout config
-func (cg *CodeGenerator) GenerateLayoutFunctions(layout *LayoutConfig) {
-	// Display utilities
-	for _, display := range layout.Layout.Display {
-		funcName := toCamelCase(display.Name)
-		funcCode := fmt.Sprintf(`// %s applies %s utility
-func %s() Class {
-	trackClass("%s")
-	return "%s"
-}`, funcName, display.Name, funcName, display.Name, display.Name)
-		cg.AddFunction(funcCode)
+// GenerateValueType creates a closed parameter type with a function for
+// each value on its scale, so editors complete the real scale and other
+// values do not compile. The functions are named prefix + value, such as
+// Spacing4, WFull or W1Of2.
+func (cg *CodeGenerator) GenerateValueType(typeName, doc, prefix string, values []string) {
+	cg.AddFunction(fmt.Sprintf(`// %s %s. Only the values below and those themegen generates
+// for a theme are valid.
+type %s struct{ value string }
+
+// String returns the value as it appears in class names. It panics for the
+// zero value, which is on no scale.
+func (v %s) String() string {
+	if v.value == "" {
+		panic("css: zero %s value")
 	}
-	// Flexbox utilities
-	for _, justify := range layout.Flexbox.Justify {
-		funcName := toCamelCase(justify.Name)
-		funcCode := fmt.Sprintf(`// %s applies %s utility
-func %s() Class {
-	trackClass("%s")
-	return "%s"
-}`, funcName, justify.Name, funcName, justify.Name, justify.Name)
-		cg.AddFunction(funcCode)
-	}
-	for _, align := range layout.Flexbox.Align {
-		funcName := toCamelCase(align.Name)
-		funcCode := fmt.Sprintf(`// %s applies %s utility
-func %s() Class {
-	trackClass("%s")
-	return "%s"
-}`, funcName, align.Name, funcName, align.Name, align.Name)
-		cg.AddFunction(funcCode)
-	}
-	for _, direction := range layout.Flexbox.Direction {
-		funcName := toCamelCase(direction.Name)
-		funcCode := fmt.Sprintf(`// %s applies %s utility
-func %s() Class {
-	trackClass("%s")
-	return "%s"
-}`, funcName, direction.Name, funcName, direction.Name, direction.Name)
-		cg.AddFunction(funcCode)
+	return v.value
+}`, typeName, doc, typeName, typeName, typeName))
+	for _, value := range values {
+		name := constName(prefix, value)
+		cg.AddFunction(fmt.Sprintf(`// %s is %s on the %s scale
+func %s() %s {
+	return %s{%s}
+}`, name, value, typeName, name, typeName, typeName, strconv.Quote(value)))
 	}
 }
 
-// GenerateTypographyFunctions creates functions from typography config
-func (cg *CodeGenerator) GenerateTypographyFunctions(typography *TypographyConfig) {
-	// Text size utilities
-	for sizeName := range typography.Typography.Sizes {
-		funcName := fmt.Sprintf("Text%s", strings.Title(sizeName))
-		if sizeName == "2xl" || sizeName == "3xl" { // Handle special cases
-			funcName = fmt.Sprintf("Text%s", strings.ToUpper(sizeName))
+// GenerateFamilyFunction creates the function returning the classes of a
+// family. A family without named values has a function without a
+// parameter; one with a value without a name, such as shadow, takes an
+// optional argument.
+func (cg *CodeGenerator) GenerateFamilyFunction(f family) {
+	named, hasDefault := false, false
+	for _, value := range f.values {
+		if value.name == "" {
+			hasDefault = true
+		} else {
+			named = true
 		}
-		funcCode := fmt.Sprintf(`// %s applies text-%s utility
-func %s() Class {
-	trackClass("text-%s")
-	return "text-%s"
-}`, funcName, sizeName, funcName, sizeName, sizeName)
-		cg.AddFunction(funcCode)
-	}
-	// Text alignment utilities
-	for _, align := range typography.Typography.Align {
-		funcName := toCamelCase(align.Name)
-		funcCode := fmt.Sprintf(`// %s applies %s utility
-func %s() Class {
-	trackClass("%s")
-	return "%s"
-}`, funcName, align.Name, funcName, align.Name, align.Name)
-		cg.AddFunction(funcCode)
-	}
-	// Font weight utilities
-	for _, weight := range typography.Typography.Weight {
-		funcName := toCamelCase(weight.Name)
-		funcCode := fmt.Sprintf(`// %s applies %s utility
-func %s() Class {
-	trackClass("%s")
-	return "%s"
-}`, funcName, weight.Name, funcName, weight.Name, weight.Name)
-		cg.AddFunction(funcCode)
 	}
-	// Font family utilities
-	for _, family := range typography.Typography.Families {
-		funcName := toCamelCase(family.Name)
-		funcCode := fmt.Sprintf(`// %s applies %s utility
+	prefix := strconv.Quote(f.prefix + "-")
+	switch {
+	case !named:
+		cg.AddFunction(fmt.Sprintf(`// %s applies %s
 func %s() Class {
-	trackClass("%s")
-	return "%s"
-}`, funcName, family.Name, funcName, family.Name, family.Name)
-		cg.AddFunction(funcCode)
-	}
-}
-
-// GenerateBorderFunctions creates functions from borders config
-func (cg *CodeGenerator) GenerateBorderFunctions(borders *BordersConfig) {
-	// Border width utilities
-	for _, prop := range borders.Borders.Width.Properties {
-		funcName := toCamelCase(prop.Name)
-		funcCode := fmt.Sprintf(`// %s applies %s utility
-func %s(width int) Class {
-	className := fmt.Sprintf("%s-%%d", width)
+	trackClass(%q)
+	return %q
+}`, f.funcName, f.doc, f.funcName, f.prefix, f.prefix))
+	case !hasDefault:
+		cg.AddFunction(fmt.Sprintf(`// %s applies %s
+func %s(%s %s) Class {
+	className := %s + %s.String()
 	trackClass(className)
 	return Class(className)
-}`, funcName, prop.Name, funcName, prop.Prefix)
-		cg.AddFunction(funcCode)
+}`, f.funcName, f.doc, f.funcName, f.arg, f.param, prefix, f.arg))
+	default:
+		cg.AddFunction(fmt.Sprintf(`// %s applies %s
+func %s(%s ...%s) Class {
+	var className string
+	if len(%s) > 0 {
+		className = %s + %s[0].String()
+	} else {
+		className = %q
 	}
-	// Border radius utilities
-	for _, prop := range borders.Borders.Radius.Properties {
-		funcName := toCamelCase(prop.Name)
-		funcCode := fmt.Sprintf(`// %s applies %s utility
-func %s(radius int) Class {
-	className := fmt.Sprintf("%s-%%d", radius)
 	trackClass(className)
 	return Class(className)
-}`, funcName, prop.Name, funcName, prop.Prefix)
-		cg.AddFunction(funcCode)
-	}
-	// Special border radius utilities
-	for _, special := range borders.Borders.Radius.Special {
-		funcName := toCamelCase(special.Name)
-		funcCode := fmt.Sprintf(`// %s applies %s utility
-func %s() Class {
-	trackClass("%s")
-	return "%s"
-}`, funcName, special.Name, funcName, special.Name, special.Name)
-		cg.AddFunction(funcCode)
+}`, f.funcName, f.doc, f.funcName, f.arg, f.param, f.arg, prefix, f.arg, f.prefix))
 	}
 }
 
@@ -193,11 +99,7 @@ func (cg *CodeGenerator) GenerateGoCode() string {
 	tmpl := `// Code generated from YAML configs. DO NOT EDIT.
 package css
 
-import (
-	"fmt"
-	"sync"
-	"github.com/computesdk/zforge/css/internal"
-)
+import "github.com/computesdk/zforge/css/internal"
 
 type Class string
 
@@ -205,38 +107,6 @@ func (c Class) String() string {
 	return string(c)
 }
 
-// Global class tracker
-var (
-	usedClasses = make(map[string]bool)
-	classMutex  sync.RWMutex
-)
-
-// trackClass registers a class as being used
-func trackClass(className string) {
-	classMutex.Lock()
-	defer classMutex.Unlock()
-	usedClasses[className] = true
-}
-
-// GetUsedClasses returns a slice of all tracked classes
-func GetUsedClasses() []string {
-	classMutex.RLock()
-	defer classMutex.RUnlock()
-	
-	classes := make([]string, 0, len(usedClasses))
-	for class := range usedClasses {
-		classes = append(classes, class)
-	}
-	return classes
-}
-
-// ResetTracking clears all tracked classes
-func ResetTracking() {
-	classMutex.Lock()
-	defer classMutex.Unlock()
-	usedClasses = make(map[string]bool)
-}
-
 // Stylesheet wraps the internal stylesheet type
 type Stylesheet struct {
 	internal interface{ GenerateCSS() string }
@@ -258,11 +128,6 @@ func GenerateUtilities() *Stylesheet {
 	return &Stylesheet{internal: internal.GenerateUtilities()}
 }
 
-// GenerateMinimalCSS generates CSS only for tracked classes
-func GenerateMinimalCSS() *Stylesheet {
-	return &Stylesheet{internal: internal.GenerateMinimalCSS(GetUsedClasses())}
-}
-
 {{range .Functions}}
 {{.}}
 
@@ -279,6 +144,71 @@ func GenerateMinimalCSS() *Stylesheet {
 	return buf.String()
 }
 
+// sortedKeys returns the keys of a config map in a stable order so the
+// generated code does not change between runs
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// namedValue is a scale entry with a class name suffix and a CSS value
+type namedValue = struct {
+	Name  string `yaml:"name"`
+	Value string `yaml:"value"`
+}
+
+// valueNames returns the non-empty names of the scales in order, without
+// duplicates
+func valueNames(scales ...[]namedValue) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, scale := range scales {
+		for _, value := range scale {
+			if value.Name != "" && !seen[value.Name] {
+				seen[value.Name] = true
+				names = append(names, value.Name)
+			}
+		}
+	}
+	return names
+}
+
+// intNames returns an integer scale as names
+func intNames(scale []int) []string {
+	names := make([]string, len(scale))
+	for i, value := range scale {
+		names[i] = strconv.Itoa(value)
+	}
+	return names
+}
+
+// shadeNames returns the numeric shades used by any color, in ascending
+// order
+func shadeNames(colors *ColorsConfig) []string {
+	seen := make(map[int]bool)
+	var shades []int
+	for _, palette := range colors.Colors {
+		for name := range palette {
+			if shade, err := strconv.Atoi(name); err == nil && !seen[shade] {
+				seen[shade] = true
+				shades = append(shades, shade)
+			}
+		}
+	}
+	sort.Ints(shades)
+	return intNames(shades)
+}
+
+// constName builds a value name from a prefix and a scale value, such as
+// W1Of2 for "1/2", W0_5 for "0.5" or MaxWScreenSm for "screen-sm"
+func constName(prefix, value string) string {
+	return prefix + toCamelCase(strings.NewReplacer("/", "Of", ".", "_").Replace(value))
+}
+
 // Helper function to convert kebab-case to CamelCase
 func toCamelCase(input string) string {
 	parts := strings.Split(input, "-")
@@ -293,215 +223,78 @@ func toCamelCase(input string) string {
 	return result
 }
 
-// GenerateSizingFunctions creates functions from sizing config
-func (cg *CodeGenerator) GenerateSizingFunctions(sizing *SizingConfig) {
-	// Width utilities with fractions
-	funcCode := `// W applies width utility
-func W(size string) Class {
-	className := fmt.Sprintf("w-%s", size)
-	trackClass(className)
-	return Class(className)
-}`
-	cg.AddFunction(funcCode)
-	// Height utilities with fractions
-	funcCode = `// H applies height utility
-func H(size string) Class {
-	className := fmt.Sprintf("h-%s", size)
-	trackClass(className)
-	return Class(className)
-}`
-	cg.AddFunction(funcCode)
-	// Max width utilities
-	funcCode = `// MaxW applies max-width utility
-func MaxW(size string) Class {
-	className := fmt.Sprintf("max-w-%s", size)
-	trackClass(className)
-	return Class(className)
-}`
-	cg.AddFunction(funcCode)
-	// Min width utilities
-	funcCode = `// MinW applies min-width utility
-func MinW(size string) Class {
-	className := fmt.Sprintf("min-w-%s", size)
-	trackClass(className)
-	return Class(className)
-}`
-	cg.AddFunction(funcCode)
-	// Max height utilities
-	funcCode = `// MaxH applies max-height utility
-func MaxH(size string) Class {
-	className := fmt.Sprintf("max-h-%s", size)
-	trackClass(className)
-	return Class(className)
-}`
-	cg.AddFunction(funcCode)
-	// Min height utilities
-	funcCode = `// MinH applies min-height utility
-func MinH(size string) Class {
-	className := fmt.Sprintf("min-h-%s", size)
-	trackClass(className)
-	return Class(className)
-}`
-	cg.AddFunction(funcCode)
-}
-
-// GeneratePositionFunctions creates functions from position config
-func (cg *CodeGenerator) GeneratePositionFunctions(position *PositionConfig) {
-	// Position type utilities
-	for _, pos := range position.Position.Types {
-		funcName := toCamelCase(pos.Name)
-		funcCode := fmt.Sprintf(`// %s applies %s utility
-func %s() Class {
-	trackClass("%s")
-	return "%s"
-}`, funcName, pos.Name, funcName, pos.Name, pos.Name)
-		cg.AddFunction(funcCode)
-	}
-	// Inset utilities (generic functions for values that can be positive or negative)
-	directions := []string{"Top", "Right", "Bottom", "Left"}
-	for _, dir := range directions {
-		funcCode := fmt.Sprintf(`// %s applies %s position utility
-func %s(value string) Class {
-	className := fmt.Sprintf("%s-%%s", value)
-	trackClass(className)
-	return Class(className)
-}`, dir, strings.ToLower(dir), dir, strings.ToLower(dir))
-		cg.AddFunction(funcCode)
-	}
-	// Inset utilities
-	funcCode := `// Inset applies inset utility
-func Inset(value string) Class {
-	className := fmt.Sprintf("inset-%s", value)
-	trackClass(className)
-	return Class(className)
-}`
-	cg.AddFunction(funcCode)
-	funcCode = `// InsetX applies horizontal inset utility
-func InsetX(value string) Class {
-	className := fmt.Sprintf("inset-x-%s", value)
-	trackClass(className)
-	return Class(className)
-}`
-	cg.AddFunction(funcCode)
-	funcCode = `// InsetY applies vertical inset utility
-func InsetY(value string) Class {
-	className := fmt.Sprintf("inset-y-%s", value)
-	trackClass(className)
-	return Class(className)
-}`
-	cg.AddFunction(funcCode)
-	// Z-index utilities
-	funcCode = `// Z applies z-index utility
-func Z(value string) Class {
-	className := fmt.Sprintf("z-%s", value)
-	trackClass(className)
-	return Class(className)
-}`
-	cg.AddFunction(funcCode)
-	// Overflow utilities
-	for _, overflow := range position.Position.Overflow.Types {
-		funcName := toCamelCase(overflow.Name)
-		funcCode := fmt.Sprintf(`// %s applies %s utility
-func %s() Class {
-	trackClass("%s")
-	return "%s"
-}`, funcName, overflow.Name, funcName, overflow.Name, overflow.Name)
+// GenerateVariantFunctions creates state variant wrappers from the
+// pseudo-class config
+func (cg *CodeGenerator) GenerateVariantFunctions(effects *EffectsConfig) {
+	for _, pseudo := range effects.Effects.PseudoClasses {
+		funcName := toCamelCase(pseudo.Name)
+		funcCode := fmt.Sprintf(`// %s applies the %s state variant to a utility
+func %s(class Class) Class {
+	return Variant("%s", class)
+}`, funcName, pseudo.Name, funcName, pseudo.Name)
 		cg.AddFunction(funcCode)
 	}
 }
 
-// GenerateEffectsFunctions creates functions from effects config
-func (cg *CodeGenerator) GenerateEffectsFunctions(effects *EffectsConfig) {
-	// Opacity utilities
-	funcCode := `// Opacity applies opacity utility
-func Opacity(value int) Class {
-	className := fmt.Sprintf("opacity-%d", value)
-	trackClass(className)
-	return Class(className)
-}`
-	cg.AddFunction(funcCode)
-	// Shadow utilities
-	funcCode = `// Shadow applies shadow utility
-func Shadow(size ...string) Class {
-	var className string
-	if len(size) > 0 && size[0] != "" {
-		className = fmt.Sprintf("shadow-%s", size[0])
-	} else {
-		className = "shadow"
-	}
-	trackClass(className)
-	return Class(className)
-}`
-	cg.AddFunction(funcCode)
-	// Cursor utilities
-	for _, cursor := range effects.Effects.Cursor.Values {
-		funcName := toCamelCase(cursor.Name)
-		funcCode := fmt.Sprintf(`// %s applies %s utility
-func %s() Class {
-	trackClass("%s")
-	return "%s"
-}`, funcName, cursor.Name, funcName, cursor.Name, cursor.Name)
-		cg.AddFunction(funcCode)
-	}
-	// User select utilities
-	for _, userSelect := range effects.Effects.UserSelect.Values {
-		funcName := toCamelCase(userSelect.Name)
-		funcCode := fmt.Sprintf(`// %s applies %s utility
-func %s() Class {
-	trackClass("%s")
-	return "%s"
-}`, funcName, userSelect.Name, funcName, userSelect.Name, userSelect.Name)
-		cg.AddFunction(funcCode)
-	}
-	// Pointer events utilities
-	for _, pointerEvents := range effects.Effects.PointerEvents.Values {
-		funcName := toCamelCase(pointerEvents.Name)
-		funcCode := fmt.Sprintf(`// %s applies %s utility
-func %s() Class {
-	trackClass("%s")
-	return "%s"
-}`, funcName, pointerEvents.Name, funcName, pointerEvents.Name, pointerEvents.Name)
-		cg.AddFunction(funcCode)
-	}
-	// Visibility utilities
-	for _, visibility := range effects.Effects.Visibility.Values {
-		funcName := toCamelCase(visibility.Name)
-		funcCode := fmt.Sprintf(`// %s applies %s utility
-func %s() Class {
-	trackClass("%s")
-	return "%s"
-}`, funcName, visibility.Name, funcName, visibility.Name, visibility.Name)
+// GenerateBreakpointFunctions creates responsive variant wrappers from the
+// breakpoints config
+func (cg *CodeGenerator) GenerateBreakpointFunctions(breakpoints *BreakpointsConfig) {
+	for _, bp := range breakpoints.Breakpoints {
+		funcName := toCamelCase(bp.Name)
+		// Identifiers cannot start with a digit, so "2xl" becomes Xl2
+		if digits := strings.IndexFunc(bp.Name, func(r rune) bool { return r < '0' || r > '9' }); digits > 0 {
+			funcName = toCamelCase(bp.Name[digits:]) + bp.Name[:digits]
+		}
+		funcCode := fmt.Sprintf(`// %s applies a utility from the %s breakpoint (min-width: %s) up
+func %s(class Class) Class {
+	return Variant("%s", class)
+}`, funcName, bp.Name, bp.MinWidth, funcName, bp.Name)
 		cg.AddFunction(funcCode)
 	}
-	// Screen reader utilities
-	for _, sr := range effects.Effects.ScreenReaders.Values {
-		funcName := toCamelCase(sr.Name)
-		funcCode := fmt.Sprintf(`// %s applies %s utility
-func %s() Class {
-	trackClass("%s")
-	return "%s"
-}`, funcName, sr.Name, funcName, sr.Name, sr.Name)
+}
+
+// GenerateArbitraryFunctions creates an arbitrary value helper for each
+// utility in the arbitrary config
+func (cg *CodeGenerator) GenerateArbitraryFunctions(arbitrary *ArbitraryConfig) {
+	for _, arb := range arbitrary.Arbitrary {
+		funcName := toCamelCase(arb.Prefix) + "Arb"
+		funcCode := fmt.Sprintf(`// %s applies %s-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func %s(value string) Class {
+	return Arb("%s", value)
+}`, funcName, arb.Prefix, funcName, arb.Prefix)
 		cg.AddFunction(funcCode)
 	}
 }
 
 // GenerateUtilitiesCode generates the complete utilities.go from all configs
 func GenerateUtilitiesCode() (string, error) {
-	spacing, colors, layout, typography, borders, sizing, position, effects, err := LoadConfig()
+	c, err := loadUtilityConfig(currentTheme())
 	if err != nil {
 		return "", err
 	}
 
 	cg := NewCodeGenerator()
 
-	cg.GenerateSpacingFunctions(spacing)
-	cg.GenerateColorFunctions(colors)
-	cg.GenerateLayoutFunctions(layout)
-	cg.GenerateTypographyFunctions(typography)
-	cg.GenerateBorderFunctions(borders)
-	cg.GenerateSizingFunctions(sizing)
-	cg.GeneratePositionFunctions(position)
-	cg.GenerateEffectsFunctions(effects)
+	set := newUtilitySet(NewStylesheet(), c)
+	for _, t := range set.types {
+		cg.GenerateValueType(t.name, t.doc, t.prefix, t.values)
+	}
+	funcs := make(map[string]string)
+	for _, f := range set.families {
+		if prefix, ok := funcs[f.funcName]; ok {
+			return "", fmt.Errorf("utilities %q and %q both generate function %s", prefix, f.prefix, f.funcName)
+		}
+		funcs[f.funcName] = f.prefix
+		cg.GenerateFamilyFunction(f)
+	}
+	cg.GenerateVariantFunctions(c.effects)
+	cg.GenerateBreakpointFunctions(c.breakpoints)
+	cg.GenerateArbitraryFunctions(c.arbitrary)
 
-	return cg.GenerateGoCode(), nil
+	code, err := format.Source([]byte(cg.GenerateGoCode()))
+	if err != nil {
+		return "", fmt.Errorf("failed to format generated code: %w", err)
+	}
+	return string(code), nil
 }
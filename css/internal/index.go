@@ -0,0 +1,256 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// utilityIndex is the full utility rule set, loaded once per theme and keyed
+// by class name so minimal CSS can be built by direct lookup. It is never
+// modified after it is built, so concurrent reads are safe.
+type utilityIndex struct {
+	// base holds the non-class rules that every stylesheet includes
+	base map[string]string
+	// classes maps a class name to its CSS properties
+	classes map[string]string
+	// selectors maps a class name to its selector template, if it has one
+	selectors map[string]string
+	// pseudoClasses maps a state variant name to its pseudo-class selector
+	pseudoClasses map[string]string
+	// breakpoints maps a responsive variant name to its media condition
+	breakpoints map[string]breakpoint
+	// variables holds the custom properties the class rules may reference
+	variables map[string]string
+	// arbitrary maps a utility prefix to the declaration template used for
+	// its bracketed arbitrary values
+	arbitrary map[string]string
+	// colors maps each color utility such as "bg-red-500" to its color, for
+	// opacity modifiers
+	colors map[string]colorUtility
+	// opacity maps an opacity scale name such as "50" to its alpha value
+	opacity map[string]string
+	// scales maps a value type such as "Spacing" to the values on its scale
+	scales map[string][]string
+	// keyframes maps an animation class to the @keyframes rule it runs
+	keyframes map[string]keyframes
+	// resets are the declarations resetting composed variables on every
+	// element; each is only added to stylesheets that reference its variable
+	resets []variableReset
+	// dark is the configured dark mode
+	dark DarkMode
+}
+
+// keyframes is a named @keyframes rule
+type keyframes struct {
+	name   string
+	frames string
+}
+
+// breakpoint is a responsive variant and its position in the config
+type breakpoint struct {
+	condition string
+	order     int
+}
+
+var (
+	// indexMu serializes building the index and changing the theme
+	indexMu sync.Mutex
+	// active is the theme and the index built from it, published together
+	// so a reader never sees a theme with the index of another
+	active atomic.Pointer[themeIndex]
+)
+
+// themeIndex is the active theme and the index built from it or the error
+// that prevented building it. Neither is set until the index is first used.
+type themeIndex struct {
+	theme *Theme
+	index *utilityIndex
+	err   error
+}
+
+// built reports whether the index has been built for the theme
+func (t *themeIndex) built() bool {
+	return t != nil && (t.index != nil || t.err != nil)
+}
+
+// currentTheme returns the active theme, or nil for the embedded defaults
+func currentTheme() *Theme {
+	if t := active.Load(); t != nil {
+		return t.theme
+	}
+	return nil
+}
+
+// loadIndex returns the shared utility index, building it on first use and
+// again after custom properties are toggled
+func loadIndex() (*utilityIndex, error) {
+	if t := active.Load(); t.built() {
+		return t.index, t.err
+	}
+	indexMu.Lock()
+	defer indexMu.Unlock()
+	if t := active.Load(); t.built() {
+		return t.index, t.err
+	}
+	theme := currentTheme()
+	index, err := buildIndex(theme)
+	active.Store(&themeIndex{theme: theme, index: index, err: err})
+	return index, err
+}
+
+// buildIndex builds the utility index from the config with theme applied
+func buildIndex(theme *Theme) (*utilityIndex, error) {
+	c, err := loadUtilityConfig(theme)
+	if err != nil {
+		return nil, err
+	}
+	stylesheet, err := generateStylesheet(c)
+	if err != nil {
+		return nil, err
+	}
+	index := newUtilityIndex(stylesheet)
+	index.resets = parseResets(index.base[resetSelector])
+	delete(index.base, resetSelector)
+	index.dark = c.effects.Effects.DarkMode
+	for _, pseudo := range c.effects.Effects.PseudoClasses {
+		index.pseudoClasses[pseudo.Name] = pseudo.Selector
+	}
+	for i, bp := range c.breakpoints.Breakpoints {
+		index.breakpoints[bp.Name] = breakpoint{
+			condition: fmt.Sprintf("(min-width: %s)", bp.MinWidth),
+			order:     i,
+		}
+	}
+	for _, arb := range c.arbitrary.Arbitrary {
+		index.arbitrary[arb.Prefix] = arb.CSSProperty
+	}
+	for colorName, shades := range c.colors.Colors {
+		for shade, hex := range shades {
+			for _, utility := range colorUtilities(c.borders) {
+				index.colors[colorClass(utility.Prefix, colorName, shade)] = colorUtility{template: utility.CSSProperty, hex: hex, variable: colorVariable(colorName, shade)}
+			}
+		}
+	}
+	for _, opacity := range c.effects.Effects.Opacity.Values {
+		index.opacity[opacity.Name] = opacity.Value
+	}
+	// The scales of the value types, as in utilities.go
+	for _, t := range newUtilitySet(NewStylesheet(), c).types {
+		index.scales[t.name] = t.values
+	}
+	for _, animation := range c.motion.Motion.Animations {
+		if animation.Keyframes.Name != "" {
+			index.keyframes[animation.Name] = keyframes{name: animation.Keyframes.Name, frames: animation.Keyframes.Frames}
+		}
+	}
+	return index, nil
+}
+
+// newUtilityIndex splits a full stylesheet into base rules and class rules
+func newUtilityIndex(s *Stylesheet) *utilityIndex {
+	return &utilityIndex{
+		base:          s.rules,
+		classes:       s.classes,
+		selectors:     s.selectors,
+		variables:     s.variables,
+		pseudoClasses: make(map[string]string),
+		breakpoints:   make(map[string]breakpoint),
+		arbitrary:     make(map[string]string),
+		colors:        make(map[string]colorUtility),
+		opacity:       make(map[string]string),
+		scales:        make(map[string][]string),
+		keyframes:     make(map[string]keyframes),
+	}
+}
+
+// rule returns the properties of a utility without variants, either from
+// the config, from an arbitrary bracketed value or from a color with an
+// opacity modifier
+func (idx *utilityIndex) rule(className string) (string, bool) {
+	if properties, ok := idx.classes[className]; ok {
+		return properties, true
+	}
+	if properties, ok := idx.arbitraryRule(className); ok {
+		return properties, true
+	}
+	return idx.opacityRule(className)
+}
+
+// addClassRules adds the rule for a used class to the stylesheet. Classes
+// with variant prefixes such as "hover:bg-blue-600" or "md:grid" are
+// resolved against the rule of their base utility; breakpoint variants and
+// media dark mode place the rule in an @media block. Animation classes also
+// add the @keyframes rule they run.
+func (idx *utilityIndex) addClassRules(s *Stylesheet, className string) {
+	if properties, ok := idx.rule(className); ok {
+		s.AddSelectorRule(className, idx.selectorTemplate(className), properties)
+		idx.addKeyframes(s, className)
+		return
+	}
+
+	variants, base := splitVariants(className)
+	if len(variants) == 0 {
+		return
+	}
+	properties, ok := idx.rule(base)
+	if !ok {
+		return
+	}
+
+	// The innermost variant is applied first, so Focus(Hover(x)) yields
+	// ".focus\:hover\:x:hover:focus"
+	selector := ClassSelector(className)
+	var conditions []string
+	order := darkMediaOrder
+	ancestor := ""
+	for i := len(variants) - 1; i >= 0; i-- {
+		if pseudo, ok := idx.pseudoClasses[variants[i]]; ok {
+			selector += pseudo
+			continue
+		}
+		if bp, ok := idx.breakpoints[variants[i]]; ok {
+			conditions = append(conditions, bp.condition)
+			order = max(order, bp.order)
+			continue
+		}
+		if variants[i] == darkVariant {
+			if mode := idx.darkMode(); mode.Strategy == DarkModeSelector {
+				ancestor = mode.Selector + " "
+			} else {
+				conditions = append(conditions, darkMediaCondition)
+			}
+			continue
+		}
+		return
+	}
+	selector = prefixSelectors(ancestor, expandSelector(idx.selectorTemplate(base), selector))
+	idx.addKeyframes(s, base)
+
+	if len(conditions) == 0 {
+		s.AddRule(selector, properties)
+		return
+	}
+	s.AddMediaRule(strings.Join(conditions, " and "), order, selector, properties)
+}
+
+// addKeyframes adds the @keyframes rule run by an animation utility
+func (idx *utilityIndex) addKeyframes(s *Stylesheet, className string) {
+	if k, ok := idx.keyframes[className]; ok {
+		s.AddKeyframes(k.name, k.frames)
+	}
+}
+
+// selectorTemplate returns the selector template of a utility without
+// variants, or "" for the class selector. Opacity modifiers keep the
+// template of their color utility.
+func (idx *utilityIndex) selectorTemplate(className string) string {
+	if template, ok := idx.selectors[className]; ok {
+		return template
+	}
+	if base, _, ok := parseOpacity(className); ok {
+		return idx.selectors[base]
+	}
+	return ""
+}
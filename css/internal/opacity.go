@@ -0,0 +1,116 @@
+package internal
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// baseColorUtilities are the background and text color utilities; the
+// borders config adds border, divide, ring and outline colors. Every color
+// utility accepts an opacity modifier such as bg-red-500/50.
+var baseColorUtilities = []ColorUtility{
+	{Prefix: "bg", CSSProperty: "background-color: {color}"},
+	{Prefix: "text", CSSProperty: "color: {color}"},
+}
+
+// colorUtilities returns the utilities generated for every color
+func colorUtilities(borders *BordersConfig) []ColorUtility {
+	return slices.Concat(baseColorUtilities, borders.Borders.Colors)
+}
+
+// colorUtility is a color class that accepts an opacity modifier
+type colorUtility struct {
+	// template is the declaration with a {color} placeholder
+	template string
+	hex      string
+	// variable is the custom property holding the color
+	variable string
+}
+
+// defaultShade is the shade of a color without shades, such as white. Its
+// utilities have no shade suffix, like bg-white.
+const defaultShade = "default"
+
+// shadeName returns the class name suffix of a shade
+func shadeName(shade string) string {
+	if shade == defaultShade {
+		return ""
+	}
+	return shade
+}
+
+// colorClass returns the class name of a color utility, such as
+// bg-red-500 or bg-white
+func colorClass(prefix, colorName, shade string) string {
+	return family{prefix: prefix + "-" + colorName}.class(shadeName(shade))
+}
+
+// colorVariable returns the custom property name for a color shade
+func colorVariable(colorName, shade string) string {
+	return fmt.Sprintf("--color-%s-%s", colorName, shade)
+}
+
+// parseOpacity splits a class name such as "bg-black/50" into the color
+// utility and opacity name
+func parseOpacity(className string) (base, opacity string, ok bool) {
+	i := strings.LastIndex(className, "/")
+	if i <= 0 || i == len(className)-1 {
+		return "", "", false
+	}
+	return className[:i], className[i+1:], true
+}
+
+// opacityRule returns the properties of a color utility with an opacity
+// modifier from the opacity scale
+func (idx *utilityIndex) opacityRule(className string) (string, bool) {
+	base, opacity, ok := parseOpacity(className)
+	if !ok {
+		return "", false
+	}
+	color, ok := idx.colors[base]
+	if !ok {
+		return "", false
+	}
+	alpha, ok := idx.opacity[opacity]
+	if !ok {
+		return "", false
+	}
+	value, err := alphaColor(color.hex, color.variable, opacity, alpha)
+	if err != nil {
+		return "", false
+	}
+	return strings.ReplaceAll(color.template, "{color}", value), true
+}
+
+// alphaColor returns a color at the given opacity, where percent is the
+// opacity as a percentage and alpha as a fraction. The color is written as
+// rgb() with an alpha channel, or mixed with transparent when custom
+// properties are enabled so the variable can still be overridden.
+func alphaColor(hex, variable, percent, alpha string) (string, error) {
+	if customProperties.Load() {
+		return fmt.Sprintf("color-mix(in srgb, var(%s) %s%%, transparent)", variable, percent), nil
+	}
+	r, g, b, err := parseHex(hex)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("rgb(%d %d %d / %s)", r, g, b, alpha), nil
+}
+
+// parseHex parses a #rgb or #rrggbb color
+func parseHex(hex string) (r, g, b uint8, err error) {
+	digits, ok := strings.CutPrefix(hex, "#")
+	if ok && len(digits) == 3 {
+		digits = string([]byte{digits[0], digits[0], digits[1], digits[1], digits[2], digits[2]})
+	}
+	if !ok || len(digits) != 6 {
+		return 0, 0, 0, fmt.Errorf("invalid hex color %q", hex)
+	}
+	value, err := strconv.ParseUint(digits, 16, 32)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("invalid hex color %q", hex)
+	}
+	return uint8(value >> 16), uint8(value >> 8), uint8(value), nil
+}
@@ -0,0 +1,112 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EscapeClassName escapes a class name for use in a CSS selector, following
+// the CSSOM CSS.escape algorithm. Characters such as ':', '/', '.' and '['
+// that are valid in a class attribute but meaningful in a selector are
+// prefixed with a backslash.
+func EscapeClassName(name string) string {
+	var b strings.Builder
+	b.Grow(len(name) + 4)
+	for i, r := range name {
+		switch {
+		case r == 0:
+			b.WriteRune('\uFFFD')
+		case r < 0x20 || r == 0x7f:
+			fmt.Fprintf(&b, "\\%x ", r)
+		case i == 0 && r >= '0' && r <= '9':
+			fmt.Fprintf(&b, "\\%x ", r)
+		case i == 1 && r >= '0' && r <= '9' && name[0] == '-':
+			fmt.Fprintf(&b, "\\%x ", r)
+		case i == 0 && r == '-' && len(name) == 1:
+			b.WriteString(`\-`)
+		case r >= 0x80, r == '-', r == '_',
+			r >= '0' && r <= '9', r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+			b.WriteRune(r)
+		default:
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// splitVariants splits a class name such as "focus:hover:bg-blue-600" into
+// its variant prefixes, outermost first, and the base utility. Colons inside
+// square brackets belong to the utility, not to a variant.
+func splitVariants(className string) (variants []string, base string) {
+	depth := 0
+	start := 0
+	for i := 0; i < len(className); i++ {
+		switch className[i] {
+		case '[':
+			depth++
+		case ']':
+			if depth > 0 {
+				depth--
+			}
+		case ':':
+			if depth == 0 {
+				variants = append(variants, className[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return variants, className[start:]
+}
+
+// splitSelectorList splits a selector list such as "a, b:not(c, d)" at its
+// top-level commas
+func splitSelectorList(list string) []string {
+	var parts []string
+	depth := 0
+	start := 0
+	for i := 0; i < len(list); i++ {
+		switch list[i] {
+		case '(', '[':
+			depth++
+		case ')', ']':
+			if depth > 0 {
+				depth--
+			}
+		case ',':
+			if depth == 0 {
+				parts = append(parts, strings.TrimSpace(list[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, strings.TrimSpace(list[start:]))
+}
+
+// prefixSelectors prefixes every selector of a selector list with an
+// ancestor selector, such as ".dark " for dark mode
+func prefixSelectors(ancestor, list string) string {
+	if ancestor == "" {
+		return list
+	}
+	parts := splitSelectorList(list)
+	for i, part := range parts {
+		parts[i] = ancestor + part
+	}
+	return strings.Join(parts, ", ")
+}
+
+// checkClassRules reports class rules that would not produce flat CSS: a
+// selector template without the class placeholder, or properties holding
+// a nested block
+func (s *Stylesheet) checkClassRules() error {
+	for _, className := range sortedKeys(s.classes) {
+		if template, ok := s.selectors[className]; ok && !strings.Contains(template, classPlaceholder) {
+			return fmt.Errorf("selector %q of %q has no %s placeholder", template, className, classPlaceholder)
+		}
+		if strings.ContainsAny(s.classes[className], "{}") {
+			return fmt.Errorf("properties of %q are not flat declarations: %q; use a selector template to style other elements", className, s.classes[className])
+		}
+	}
+	return nil
+}
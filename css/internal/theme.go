@@ -0,0 +1,268 @@
+package internal
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// configTypes maps each embedded config file to a constructor for the
+// struct it is parsed into, so theme overlays can be checked against it
+var configTypes = map[string]func() any{
+	"config/spacing.yaml":     func() any { return new(SpacingConfig) },
+	"config/colors.yaml":      func() any { return new(ColorsConfig) },
+	"config/layout.yaml":      func() any { return new(LayoutConfig) },
+	"config/typography.yaml":  func() any { return new(TypographyConfig) },
+	"config/borders.yaml":     func() any { return new(BordersConfig) },
+	"config/sizing.yaml":      func() any { return new(SizingConfig) },
+	"config/position.yaml":    func() any { return new(PositionConfig) },
+	"config/effects.yaml":     func() any { return new(EffectsConfig) },
+	"config/breakpoints.yaml": func() any { return new(BreakpointsConfig) },
+	"config/arbitrary.yaml":   func() any { return new(ArbitraryConfig) },
+	"config/gradients.yaml":   func() any { return new(GradientsConfig) },
+	"config/motion.yaml":      func() any { return new(MotionConfig) },
+	"config/transforms.yaml":  func() any { return new(TransformsConfig) },
+}
+
+// Theme is a user theme applied on top of the embedded config. Its YAML has
+// two sections keyed by config paths such as "colors" or "spacing.scale",
+// where the first element is a top-level key of one of the config files:
+//
+//	extend:
+//	  colors:
+//	    brand:
+//	      500: "#ff0066"
+//	replace:
+//	  spacing.scale: [0, 1, 2, 4, 8]
+//
+// Values under extend are merged into the defaults: mappings are merged by
+// key, and sequences are appended to, with an entry replacing the default
+// entry that has the same name or prefix. Values under replace take the
+// place of the default value at that path. Replacements are applied first.
+type Theme struct {
+	ops map[string][]themeOp
+}
+
+// themeOp is one extend or replace entry of a theme
+type themeOp struct {
+	path    []string
+	value   *yaml.Node
+	replace bool
+}
+
+// ParseTheme parses a theme and checks every entry against the schema of
+// the config file it applies to
+func ParseTheme(data []byte) (*Theme, error) {
+	var file struct {
+		Extend  yaml.Node `yaml:"extend"`
+		Replace yaml.Node `yaml:"replace"`
+	}
+	if err := decodeStrict(data, &file); err != nil {
+		return nil, fmt.Errorf("invalid theme: %w", err)
+	}
+
+	sections, err := configSections()
+	if err != nil {
+		return nil, err
+	}
+
+	theme := &Theme{ops: make(map[string][]themeOp)}
+	for _, section := range []struct {
+		node    *yaml.Node
+		replace bool
+	}{{&file.Replace, true}, {&file.Extend, false}} {
+		if section.node.Kind == 0 {
+			continue
+		}
+		if section.node.Kind != yaml.MappingNode {
+			return nil, fmt.Errorf("invalid theme: line %d: expected a mapping of config paths", section.node.Line)
+		}
+		for i := 0; i < len(section.node.Content); i += 2 {
+			key, value := section.node.Content[i], section.node.Content[i+1]
+			path := strings.Split(key.Value, ".")
+			filename, ok := sections[path[0]]
+			if !ok {
+				return nil, fmt.Errorf("invalid theme: line %d: unknown config section %q", key.Line, path[0])
+			}
+			op := themeOp{path: path, value: value, replace: section.replace}
+			if err := op.check(filename); err != nil {
+				return nil, fmt.Errorf("invalid theme: %s: %w", key.Value, err)
+			}
+			theme.ops[filename] = append(theme.ops[filename], op)
+		}
+	}
+	return theme, nil
+}
+
+// check decodes the entry on its own into the config type of the file, so
+// unknown keys and values of the wrong type are reported with the theme
+func (op themeOp) check(filename string) error {
+	data, err := yaml.Marshal(nestNode(op.path, op.value))
+	if err != nil {
+		return err
+	}
+	return decodeStrict(data, configTypes[filename]())
+}
+
+// apply merges the theme entries for a config file into its parsed document
+func (t *Theme) apply(filename string, doc *yaml.Node) error {
+	if t == nil || len(t.ops[filename]) == 0 {
+		return nil
+	}
+	if len(doc.Content) == 0 {
+		doc.Content = []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}
+	}
+	for _, op := range t.ops[filename] {
+		parent := doc.Content[0]
+		for _, key := range op.path[:len(op.path)-1] {
+			parent = mappingValue(parent, key, true)
+			if parent == nil {
+				return fmt.Errorf("%s: %s is not a mapping", filename, strings.Join(op.path, "."))
+			}
+		}
+		last := op.path[len(op.path)-1]
+		target := mappingValue(parent, last, false)
+		// The entry is copied since a theme is applied to every load
+		value := copyNode(op.value)
+		switch {
+		case target == nil:
+			parent.Content = append(parent.Content, scalarNode(last), value)
+		case op.replace:
+			*target = *value
+		default:
+			mergeNode(target, value)
+		}
+	}
+	return nil
+}
+
+// mergeNode merges src into dst for extend entries
+func mergeNode(dst, src *yaml.Node) {
+	switch {
+	case dst.Kind == yaml.MappingNode && src.Kind == yaml.MappingNode:
+		for i := 0; i < len(src.Content); i += 2 {
+			if value := mappingValue(dst, src.Content[i].Value, false); value != nil {
+				mergeNode(value, src.Content[i+1])
+			} else {
+				dst.Content = append(dst.Content, src.Content[i], src.Content[i+1])
+			}
+		}
+	case dst.Kind == yaml.SequenceNode && src.Kind == yaml.SequenceNode:
+		for _, item := range src.Content {
+			if i := entryIndex(dst, item); i >= 0 {
+				dst.Content[i] = item
+			} else {
+				dst.Content = append(dst.Content, item)
+			}
+		}
+	default:
+		*dst = *src
+	}
+}
+
+// entryIndex returns the position of the sequence entry that item replaces:
+// the mapping with the same name or prefix, or the equal scalar
+func entryIndex(seq, item *yaml.Node) int {
+	for i, existing := range seq.Content {
+		if item.Kind == yaml.ScalarNode && existing.Kind == yaml.ScalarNode && item.Value == existing.Value {
+			return i
+		}
+		if item.Kind != yaml.MappingNode || existing.Kind != yaml.MappingNode {
+			continue
+		}
+		for _, key := range []string{"name", "prefix"} {
+			a, b := mappingValue(item, key, false), mappingValue(existing, key, false)
+			if a != nil && b != nil && a.Value == b.Value {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// mappingValue returns the value for key in a mapping node. With create, a
+// missing key is added with an empty mapping. It returns nil if node is not
+// a mapping or the key is missing.
+func mappingValue(node *yaml.Node, key string, create bool) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	if !create {
+		return nil
+	}
+	value := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	node.Content = append(node.Content, scalarNode(key), value)
+	return value
+}
+
+// nestNode wraps value in mappings for each element of path
+func nestNode(path []string, value *yaml.Node) *yaml.Node {
+	for i := len(path) - 1; i >= 0; i-- {
+		value = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map", Content: []*yaml.Node{scalarNode(path[i]), value}}
+	}
+	return value
+}
+
+// copyNode returns a deep copy of node
+func copyNode(node *yaml.Node) *yaml.Node {
+	c := *node
+	c.Content = make([]*yaml.Node, len(node.Content))
+	for i, child := range node.Content {
+		c.Content[i] = copyNode(child)
+	}
+	return &c
+}
+
+func scalarNode(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+}
+
+// decodeStrict parses YAML into target, rejecting keys target has no field for
+func decodeStrict(data []byte, target any) error {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	return dec.Decode(target)
+}
+
+// configSections maps each top-level key of the embedded config files to
+// the file it is defined in
+func configSections() (map[string]string, error) {
+	sections := make(map[string]string)
+	for filename := range configTypes {
+		data, err := configFS.ReadFile(filename)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", filename, err)
+		}
+		var keys map[string]yaml.Node
+		if err := yaml.Unmarshal(data, &keys); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", filename, err)
+		}
+		for key := range keys {
+			sections[key] = filename
+		}
+	}
+	return sections, nil
+}
+
+// SetTheme applies a theme to every subsequent config load and rebuilds the
+// utility index, so generated CSS reflects it. A nil theme restores the
+// embedded defaults. The merged config is checked before it is applied, and
+// the theme is published together with the index built from it.
+func SetTheme(theme *Theme) error {
+	indexMu.Lock()
+	defer indexMu.Unlock()
+
+	index, err := buildIndex(theme)
+	if err != nil {
+		return err
+	}
+	active.Store(&themeIndex{theme: theme, index: index})
+	return nil
+}
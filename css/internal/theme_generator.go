@@ -0,0 +1,161 @@
+package internal
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"strings"
+)
+
+// GenerateThemeCode returns the source of package pkg with the declarations
+// of the themed utilities code that the base code lacks, such as BgBrand for
+// a new color or Spacing13 for a new spacing value. The declarations use
+// the css package for Class, the value types and class registration. If
+// embed is not empty, the file also embeds the theme file of that name and
+// applies it with css.SetTheme when the package is initialized.
+func GenerateThemeCode(base, themed, pkg, embed string) (string, error) {
+	fset := token.NewFileSet()
+	baseFile, err := parser.ParseFile(fset, "base.go", base, 0)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse base code: %w", err)
+	}
+	themedFile, err := parser.ParseFile(fset, "themed.go", themed, parser.ParseComments)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse themed code: %w", err)
+	}
+
+	// Names declared by the css package are qualified in the output. The
+	// generated code also calls functions declared outside utilities.go.
+	cssNames := map[string]string{
+		"trackClass": "css.Register",
+		"Variant":    "css.Variant",
+		"Arb":        "css.Arb",
+	}
+	for name := range topLevelNames(baseFile) {
+		cssNames[name] = "css." + name
+	}
+
+	// The values of every value type go ahead of the functions
+	var decls, values bytes.Buffer
+	for _, decl := range themedFile.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok || fd.Recv != nil || cssNames[fd.Name.Name] != "" {
+			continue
+		}
+		qualify(fd.Type, cssNames)
+		qualify(fd.Body, cssNames)
+		out := &decls
+		if lit := valueLiteral(fd); lit != nil {
+			// The value types are closed, so values such as Spacing{"13"}
+			// are built with css.ThemeValue outside the css package
+			fd.Body.List[0].(*ast.ReturnStmt).Results[0] = &ast.CallExpr{
+				Fun:    &ast.IndexExpr{X: &ast.Ident{NamePos: lit.Pos(), Name: "css.ThemeValue"}, Index: lit.Type},
+				Lparen: lit.Lbrace,
+				Args:   lit.Elts,
+				Rparen: lit.Rbrace,
+			}
+			out = &values
+		}
+		if err := printer.Fprint(out, fset, &printer.CommentedNode{Node: fd, Comments: themedFile.Comments}); err != nil {
+			return "", err
+		}
+		out.WriteString("\n\n")
+	}
+
+	var src bytes.Buffer
+	fmt.Fprintf(&src, "// Code generated by themegen. DO NOT EDIT.\n\npackage %s\n\nimport (\n", pkg)
+	if embed != "" {
+		src.WriteString("\t_ \"embed\"\n")
+	}
+	if strings.Contains(decls.String(), "fmt.") {
+		src.WriteString("\t\"fmt\"\n")
+	}
+	src.WriteString("\n\t\"github.com/computesdk/zforge/css\"\n)\n\n")
+	if embed != "" {
+		fmt.Fprintf(&src, `//go:embed %s
+var theme []byte
+
+func init() {
+	if err := css.SetTheme(theme); err != nil {
+		panic(err)
+	}
+}
+
+`, embed)
+	}
+	src.Write(values.Bytes())
+	src.Write(decls.Bytes())
+
+	code, err := format.Source(src.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("failed to format generated code: %w", err)
+	}
+	return string(code), nil
+}
+
+// valueLiteral returns the value a function of a value type returns, such
+// as Spacing{"13"} for Spacing13, or nil for other functions
+func valueLiteral(fd *ast.FuncDecl) *ast.CompositeLit {
+	if fd.Type.Params.NumFields() != 0 || len(fd.Body.List) != 1 {
+		return nil
+	}
+	ret, ok := fd.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return nil
+	}
+	lit, ok := ret.Results[0].(*ast.CompositeLit)
+	if !ok || len(lit.Elts) != 1 {
+		return nil
+	}
+	return lit
+}
+
+// topLevelNames returns the names of the package-level functions, types,
+// constants and variables declared in file
+func topLevelNames(file *ast.File) map[string]bool {
+	names := make(map[string]bool)
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if decl.Recv == nil {
+				names[decl.Name.Name] = true
+			}
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					names[spec.Name.Name] = true
+				case *ast.ValueSpec:
+					for _, name := range spec.Names {
+						names[name.Name] = true
+					}
+				}
+			}
+		}
+	}
+	return names
+}
+
+// qualify renames the identifiers in node that refer to css package names.
+// Selector names are left alone, since they belong to another package.
+func qualify(node ast.Node, names map[string]string) {
+	if node == nil {
+		return
+	}
+	ast.Inspect(node, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			qualify(n.X, names)
+			return false
+		case *ast.Ident:
+			if qualified, ok := names[n.Name]; ok {
+				n.Name = qualified
+			}
+		}
+		return true
+	})
+}
@@ -0,0 +1,121 @@
+package internal
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ValidateClass reports whether a class name has a rule: every variant
+// prefix must be a configured state, breakpoint or dark mode, and the base
+// utility must exist in the config or be a valid arbitrary value. For an
+// out-of-scale utility such as "p-13" the error lists the valid values.
+func ValidateClass(className string) error {
+	idx, err := loadIndex()
+	if err != nil {
+		return err
+	}
+
+	variants, base := splitVariants(className)
+	for _, variant := range variants {
+		if !idx.knownVariant(variant) {
+			return fmt.Errorf("unknown variant %q in %q", variant, className)
+		}
+	}
+	if base == "" {
+		return fmt.Errorf("empty utility in %q", className)
+	}
+	if _, ok := idx.rule(base); ok {
+		return nil
+	}
+
+	if color, opacity, ok := parseOpacity(base); ok {
+		if _, ok := idx.colors[color]; ok {
+			return fmt.Errorf("unknown opacity %q in %q; valid values are %s", opacity, className, strings.Join(idx.opacityScale(), ", "))
+		}
+	}
+	if prefix, value, ok := parseArbitrary(base); ok {
+		if _, ok := idx.arbitrary[prefix]; !ok {
+			return fmt.Errorf("utility %q does not accept arbitrary values", prefix)
+		}
+		return ValidateArbitraryValue(value)
+	}
+	if i := strings.LastIndex(base, "-"); i > 0 {
+		if values := idx.scale(base[:i+1]); len(values) > 0 {
+			return fmt.Errorf("unknown utility %q; valid values for %s are %s", base, base[:i+1], strings.Join(values, ", "))
+		}
+	}
+	return fmt.Errorf("unknown utility %q", base)
+}
+
+// ValidateScaleValue reports whether value is on the scale of a value type,
+// such as "13" for "Spacing" after a theme adds 13 to the spacing scale
+func ValidateScaleValue(typeName, value string) error {
+	idx, err := loadIndex()
+	if err != nil {
+		return err
+	}
+	values, ok := idx.scales[typeName]
+	switch {
+	case !ok:
+		return fmt.Errorf("unknown value type %s", typeName)
+	case value == "":
+		return fmt.Errorf("empty %s value", typeName)
+	case !slices.Contains(values, value):
+		return fmt.Errorf("unknown %s value %q; valid values are %s", typeName, value, strings.Join(values, ", "))
+	}
+	return nil
+}
+
+// knownVariant reports whether name is a configured variant prefix
+func (idx *utilityIndex) knownVariant(name string) bool {
+	if _, ok := idx.pseudoClasses[name]; ok {
+		return true
+	}
+	if _, ok := idx.breakpoints[name]; ok {
+		return true
+	}
+	return name == darkVariant
+}
+
+// opacityScale returns the opacity modifier values in ascending order
+func (idx *utilityIndex) opacityScale() []string {
+	values := make([]string, 0, len(idx.opacity))
+	for name := range idx.opacity {
+		values = append(values, name)
+	}
+	sortScale(values)
+	return values
+}
+
+// scale returns the values of the utilities starting with prefix, such as
+// "0", "1", "2" for "p-", numbers first in ascending order
+func (idx *utilityIndex) scale(prefix string) []string {
+	var values []string
+	for className := range idx.classes {
+		value, ok := strings.CutPrefix(className, prefix)
+		if ok && value != "" && !strings.Contains(value, "-") {
+			values = append(values, value)
+		}
+	}
+	sortScale(values)
+	return values
+}
+
+// sortScale sorts scale values, numbers first in ascending order
+func sortScale(values []string) {
+	sort.Slice(values, func(i, j int) bool {
+		a, errA := strconv.ParseFloat(values[i], 64)
+		b, errB := strconv.ParseFloat(values[j], 64)
+		switch {
+		case errA == nil && errB == nil:
+			return a < b
+		case errA == nil || errB == nil:
+			return errA == nil
+		default:
+			return values[i] < values[j]
+		}
+	})
+}
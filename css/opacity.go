@@ -0,0 +1,12 @@
+package css
+
+// WithOpacity adds an opacity modifier to a color utility, so
+// WithOpacity(BgRed(Shade500()), Opacity50()) yields "bg-red-500/50". The
+// minimal CSS writes the color with an alpha channel, such as
+// "background-color: rgb(239 68 68 / 0.5)". The opacity must be on the
+// effects.opacity scale.
+func WithOpacity(class Class, opacity OpacityLevel) Class {
+	className := string(class) + "/" + opacity.String()
+	trackClass(className)
+	return Class(className)
+}
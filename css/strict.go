@@ -0,0 +1,51 @@
+package css
+
+import (
+	"os"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/computesdk/zforge/css/internal"
+)
+
+// strict makes every generated utility function validate its class
+var strict atomic.Bool
+
+func init() {
+	if enabled, _ := strconv.ParseBool(os.Getenv("ZFORGE_STRICT")); enabled {
+		strict.Store(true)
+	}
+}
+
+// SetStrict enables or disables strict mode. In strict mode the utility
+// functions, variant wrappers and Arb panic with the list of valid values
+// when they build a class that has no rule in the config, such as
+// P(Spacing3()) after a theme removes 3 from the spacing scale. Strict mode
+// is off by default and is also enabled by setting the ZFORGE_STRICT
+// environment variable to a true value; it is meant for development and
+// tests.
+func SetStrict(enabled bool) {
+	strict.Store(enabled)
+}
+
+// Strict reports whether strict mode is enabled
+func Strict() bool {
+	return strict.Load()
+}
+
+// Validate returns an error if the class would produce no CSS, because its
+// utility is not in the config, its value is out of scale or one of its
+// variants is unknown. It never panics, regardless of strict mode.
+func Validate(class Class) error {
+	return internal.ValidateClass(string(class))
+}
+
+// checkStrict panics if strict mode is enabled and the class is invalid
+func checkStrict(className string) {
+	if !strict.Load() {
+		return
+	}
+	if err := internal.ValidateClass(className); err != nil {
+		panic("css: " + err.Error())
+	}
+}
@@ -0,0 +1,72 @@
+package css
+
+import (
+	"reflect"
+
+	"github.com/computesdk/zforge/css/internal"
+)
+
+// SetTheme applies a user theme on top of the built-in config, so
+// subsequent stylesheets, Validate and strict mode use the merged config.
+// The theme is YAML with an extend section, whose values are merged into
+// the defaults, and a replace section, whose values take their place:
+//
+//	extend:
+//	  colors:
+//	    brand:
+//	      500: "#ff0066"
+//	  typography.families:
+//	    - name: font-brand
+//	      css_property: "font-family: Inter, sans-serif"
+//	replace:
+//	  spacing.scale: [0, 1, 2, 4, 8, 16]
+//
+// Keys are paths into the config files, starting with one of their
+// top-level keys. Every entry is checked against the config schema, and the
+// current theme is kept if the new one is rejected. Call SetTheme at startup,
+// before rendering; the themegen command generates typed functions for the
+// classes a theme adds.
+func SetTheme(yaml []byte) error {
+	theme, err := internal.ParseTheme(yaml)
+	if err != nil {
+		return err
+	}
+	return internal.SetTheme(theme)
+}
+
+// ResetTheme restores the built-in config
+func ResetTheme() {
+	internal.SetTheme(nil)
+}
+
+// Register records a class built outside this package, such as by the
+// functions themegen generates, in the same way as the utility functions
+// of this package: it is validated in strict mode and tracked by the
+// deprecated package-level tracker.
+func Register(className string) Class {
+	trackClass(className)
+	return Class(className)
+}
+
+// ThemeValue returns the value of a value type, such as Spacing, for an
+// entry a theme adds to its scale. It is meant for the functions themegen
+// generates, which must be called once the theme is applied. It panics if
+// the value is empty or not on the scale of the active config, so it cannot
+// build values the generated ones do not cover.
+func ThemeValue[T ~struct{ value string }](value string) T {
+	if err := internal.ValidateScaleValue(reflect.TypeFor[T]().Name(), value); err != nil {
+		panic("css: " + err.Error())
+	}
+	return T{value}
+}
+
+// SetCustomProperties selects whether color and spacing utilities reference
+// CSS custom properties instead of literal values. When enabled, stylesheets
+// start with a :root block defining the variables their rules use, such as
+// "--color-red-500: #ef4444" and "--spacing-4: 1.00rem", and bg-red-500
+// becomes "background-color: var(--color-red-500)". Tenants and color
+// schemes can then be switched by overriding the variables in CSS, without
+// regenerating the utilities. It is meant to be called once at startup.
+func SetCustomProperties(enabled bool) {
+	internal.SetCustomProperties(enabled)
+}
@@ -0,0 +1,137 @@
+package css
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/computesdk/zforge/css/internal"
+)
+
+// Tracker collects the classes used by a single render, so the minimal CSS
+// for one response never includes classes from another. A Tracker is safe
+// for concurrent use.
+type Tracker struct {
+	mu      sync.Mutex
+	classes map[string]struct{}
+}
+
+// NewTracker creates an empty tracker
+func NewTracker() *Tracker {
+	return &Tracker{classes: make(map[string]struct{})}
+}
+
+// Track records the given classes as used
+func (t *Tracker) Track(classes ...Class) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, class := range classes {
+		if class != "" {
+			t.classes[string(class)] = struct{}{}
+		}
+	}
+}
+
+// Classes returns the tracked classes in sorted order
+func (t *Tracker) Classes() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	classes := make([]string, 0, len(t.classes))
+	for class := range t.classes {
+		classes = append(classes, class)
+	}
+	sort.Strings(classes)
+	return classes
+}
+
+// Len returns the number of tracked classes
+func (t *Tracker) Len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.classes)
+}
+
+// Reset clears all tracked classes
+func (t *Tracker) Reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.classes = make(map[string]struct{})
+}
+
+// GenerateMinimalCSS generates CSS only for the tracked classes
+func (t *Tracker) GenerateMinimalCSS() *Stylesheet {
+	return &Stylesheet{internal: internal.GenerateMinimalCSS(t.Classes())}
+}
+
+type trackerKey struct{}
+
+// WithTracker returns a copy of ctx that carries the tracker, so handlers
+// and components can share one tracker per request
+func WithTracker(ctx context.Context, t *Tracker) context.Context {
+	return context.WithValue(ctx, trackerKey{}, t)
+}
+
+// TrackerFromContext returns the tracker carried by ctx, or nil
+func TrackerFromContext(ctx context.Context) *Tracker {
+	t, _ := ctx.Value(trackerKey{}).(*Tracker)
+	return t
+}
+
+// defaultTracker backs the deprecated package-level tracking API. It
+// records classes unless packageTrackingOff is set.
+var (
+	defaultTracker     = NewTracker()
+	packageTrackingOff atomic.Bool
+)
+
+// trackClass is called by every utility function with the class it built.
+// It validates the class in strict mode and, unless disabled, registers it
+// with the deprecated package-level tracker.
+func trackClass(className string) {
+	checkStrict(className)
+	if !packageTrackingOff.Load() {
+		defaultTracker.Track(Class(className))
+	}
+}
+
+// SetPackageTracking enables or disables the package-level tracker read by
+// GetUsedClasses and GenerateMinimalCSS. It is on by default for
+// compatibility; programs that render with a Tracker can disable it, so
+// utility functions no longer write to shared state. Disabling it also
+// clears the tracked classes.
+//
+// Deprecated: use a Tracker scoped to one render instead; html.Element.Render
+// already does.
+func SetPackageTracking(enabled bool) {
+	packageTrackingOff.Store(!enabled)
+	if !enabled {
+		defaultTracker.Reset()
+	}
+}
+
+// GetUsedClasses returns a slice of all classes constructed since the last
+// ResetTracking.
+//
+// Deprecated: the package-level tracker is shared by every goroutine, so
+// concurrent requests see each other's classes. Use a Tracker scoped to one
+// render instead; html.Element.Render already does.
+func GetUsedClasses() []string {
+	return defaultTracker.Classes()
+}
+
+// ResetTracking clears all classes tracked by the package-level tracker.
+//
+// Deprecated: use a Tracker scoped to one render instead.
+func ResetTracking() {
+	defaultTracker.Reset()
+}
+
+// GenerateMinimalCSS generates CSS only for classes tracked by the
+// package-level tracker.
+//
+// Deprecated: use Tracker.GenerateMinimalCSS instead.
+func GenerateMinimalCSS() *Stylesheet {
+	return defaultTracker.GenerateMinimalCSS()
+}
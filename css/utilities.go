@@ -1,772 +1,5101 @@
 // Code generated from YAML configs. DO NOT EDIT.
 package css
 
-import (
-	"fmt"
-	"sync"
+import "github.com/computesdk/zforge/css/internal"
 
-	"github.com/computesdk/zforge/css/internal"
-)
+type Class string
+
+func (c Class) String() string {
+	return string(c)
+}
+
+// Stylesheet wraps the internal stylesheet type
+type Stylesheet struct {
+	internal interface{ GenerateCSS() string }
+}
+
+// Generate returns the CSS string
+func (s *Stylesheet) Generate() string {
+	if s.internal == nil {
+		return ""
+	}
+	if gen, ok := s.internal.(interface{ GenerateCSS() string }); ok {
+		return gen.GenerateCSS()
+	}
+	return ""
+}
+
+// GenerateUtilities creates CSS rules using the config-driven approach
+func GenerateUtilities() *Stylesheet {
+	return &Stylesheet{internal: internal.GenerateUtilities()}
+}
+
+// Spacing is a value on the spacing scale. Only the values below and those themegen generates
+// for a theme are valid.
+type Spacing struct{ value string }
+
+// String returns the value as it appears in class names. It panics for the
+// zero value, which is on no scale.
+func (v Spacing) String() string {
+	if v.value == "" {
+		panic("css: zero Spacing value")
+	}
+	return v.value
+}
+
+// Spacing0 is 0 on the Spacing scale
+func Spacing0() Spacing {
+	return Spacing{"0"}
+}
+
+// Spacing1 is 1 on the Spacing scale
+func Spacing1() Spacing {
+	return Spacing{"1"}
+}
+
+// Spacing2 is 2 on the Spacing scale
+func Spacing2() Spacing {
+	return Spacing{"2"}
+}
+
+// Spacing3 is 3 on the Spacing scale
+func Spacing3() Spacing {
+	return Spacing{"3"}
+}
+
+// Spacing4 is 4 on the Spacing scale
+func Spacing4() Spacing {
+	return Spacing{"4"}
+}
+
+// Spacing5 is 5 on the Spacing scale
+func Spacing5() Spacing {
+	return Spacing{"5"}
+}
+
+// Spacing6 is 6 on the Spacing scale
+func Spacing6() Spacing {
+	return Spacing{"6"}
+}
+
+// Spacing7 is 7 on the Spacing scale
+func Spacing7() Spacing {
+	return Spacing{"7"}
+}
+
+// Spacing8 is 8 on the Spacing scale
+func Spacing8() Spacing {
+	return Spacing{"8"}
+}
+
+// Spacing9 is 9 on the Spacing scale
+func Spacing9() Spacing {
+	return Spacing{"9"}
+}
+
+// Spacing10 is 10 on the Spacing scale
+func Spacing10() Spacing {
+	return Spacing{"10"}
+}
+
+// Spacing11 is 11 on the Spacing scale
+func Spacing11() Spacing {
+	return Spacing{"11"}
+}
+
+// Spacing12 is 12 on the Spacing scale
+func Spacing12() Spacing {
+	return Spacing{"12"}
+}
+
+// Spacing14 is 14 on the Spacing scale
+func Spacing14() Spacing {
+	return Spacing{"14"}
+}
+
+// Spacing16 is 16 on the Spacing scale
+func Spacing16() Spacing {
+	return Spacing{"16"}
+}
+
+// Spacing20 is 20 on the Spacing scale
+func Spacing20() Spacing {
+	return Spacing{"20"}
+}
+
+// Spacing24 is 24 on the Spacing scale
+func Spacing24() Spacing {
+	return Spacing{"24"}
+}
+
+// Spacing28 is 28 on the Spacing scale
+func Spacing28() Spacing {
+	return Spacing{"28"}
+}
+
+// Spacing32 is 32 on the Spacing scale
+func Spacing32() Spacing {
+	return Spacing{"32"}
+}
+
+// Spacing36 is 36 on the Spacing scale
+func Spacing36() Spacing {
+	return Spacing{"36"}
+}
+
+// Spacing40 is 40 on the Spacing scale
+func Spacing40() Spacing {
+	return Spacing{"40"}
+}
+
+// Spacing44 is 44 on the Spacing scale
+func Spacing44() Spacing {
+	return Spacing{"44"}
+}
+
+// Spacing48 is 48 on the Spacing scale
+func Spacing48() Spacing {
+	return Spacing{"48"}
+}
+
+// Spacing52 is 52 on the Spacing scale
+func Spacing52() Spacing {
+	return Spacing{"52"}
+}
+
+// Spacing56 is 56 on the Spacing scale
+func Spacing56() Spacing {
+	return Spacing{"56"}
+}
+
+// Spacing60 is 60 on the Spacing scale
+func Spacing60() Spacing {
+	return Spacing{"60"}
+}
+
+// Spacing64 is 64 on the Spacing scale
+func Spacing64() Spacing {
+	return Spacing{"64"}
+}
+
+// Spacing72 is 72 on the Spacing scale
+func Spacing72() Spacing {
+	return Spacing{"72"}
+}
+
+// Spacing80 is 80 on the Spacing scale
+func Spacing80() Spacing {
+	return Spacing{"80"}
+}
+
+// Spacing96 is 96 on the Spacing scale
+func Spacing96() Spacing {
+	return Spacing{"96"}
+}
+
+// Shade is a color shade. Only the values below and those themegen generates
+// for a theme are valid.
+type Shade struct{ value string }
+
+// String returns the value as it appears in class names. It panics for the
+// zero value, which is on no scale.
+func (v Shade) String() string {
+	if v.value == "" {
+		panic("css: zero Shade value")
+	}
+	return v.value
+}
+
+// Shade50 is 50 on the Shade scale
+func Shade50() Shade {
+	return Shade{"50"}
+}
+
+// Shade100 is 100 on the Shade scale
+func Shade100() Shade {
+	return Shade{"100"}
+}
+
+// Shade200 is 200 on the Shade scale
+func Shade200() Shade {
+	return Shade{"200"}
+}
+
+// Shade300 is 300 on the Shade scale
+func Shade300() Shade {
+	return Shade{"300"}
+}
+
+// Shade400 is 400 on the Shade scale
+func Shade400() Shade {
+	return Shade{"400"}
+}
+
+// Shade500 is 500 on the Shade scale
+func Shade500() Shade {
+	return Shade{"500"}
+}
+
+// Shade600 is 600 on the Shade scale
+func Shade600() Shade {
+	return Shade{"600"}
+}
+
+// Shade700 is 700 on the Shade scale
+func Shade700() Shade {
+	return Shade{"700"}
+}
+
+// Shade800 is 800 on the Shade scale
+func Shade800() Shade {
+	return Shade{"800"}
+}
+
+// Shade900 is 900 on the Shade scale
+func Shade900() Shade {
+	return Shade{"900"}
+}
+
+// Shade950 is 950 on the Shade scale
+func Shade950() Shade {
+	return Shade{"950"}
+}
+
+// GridTracks is a number of grid columns or rows. Only the values below and those themegen generates
+// for a theme are valid.
+type GridTracks struct{ value string }
+
+// String returns the value as it appears in class names. It panics for the
+// zero value, which is on no scale.
+func (v GridTracks) String() string {
+	if v.value == "" {
+		panic("css: zero GridTracks value")
+	}
+	return v.value
+}
+
+// Tracks1 is 1 on the GridTracks scale
+func Tracks1() GridTracks {
+	return GridTracks{"1"}
+}
+
+// Tracks2 is 2 on the GridTracks scale
+func Tracks2() GridTracks {
+	return GridTracks{"2"}
+}
+
+// Tracks3 is 3 on the GridTracks scale
+func Tracks3() GridTracks {
+	return GridTracks{"3"}
+}
+
+// Tracks4 is 4 on the GridTracks scale
+func Tracks4() GridTracks {
+	return GridTracks{"4"}
+}
+
+// Tracks5 is 5 on the GridTracks scale
+func Tracks5() GridTracks {
+	return GridTracks{"5"}
+}
+
+// Tracks6 is 6 on the GridTracks scale
+func Tracks6() GridTracks {
+	return GridTracks{"6"}
+}
+
+// Tracks7 is 7 on the GridTracks scale
+func Tracks7() GridTracks {
+	return GridTracks{"7"}
+}
+
+// Tracks8 is 8 on the GridTracks scale
+func Tracks8() GridTracks {
+	return GridTracks{"8"}
+}
+
+// Tracks9 is 9 on the GridTracks scale
+func Tracks9() GridTracks {
+	return GridTracks{"9"}
+}
+
+// Tracks10 is 10 on the GridTracks scale
+func Tracks10() GridTracks {
+	return GridTracks{"10"}
+}
+
+// Tracks11 is 11 on the GridTracks scale
+func Tracks11() GridTracks {
+	return GridTracks{"11"}
+}
+
+// Tracks12 is 12 on the GridTracks scale
+func Tracks12() GridTracks {
+	return GridTracks{"12"}
+}
+
+// BorderWidth is a value on the border width scale. Only the values below and those themegen generates
+// for a theme are valid.
+type BorderWidth struct{ value string }
+
+// String returns the value as it appears in class names. It panics for the
+// zero value, which is on no scale.
+func (v BorderWidth) String() string {
+	if v.value == "" {
+		panic("css: zero BorderWidth value")
+	}
+	return v.value
+}
+
+// BorderWidth0 is 0 on the BorderWidth scale
+func BorderWidth0() BorderWidth {
+	return BorderWidth{"0"}
+}
+
+// BorderWidth1 is 1 on the BorderWidth scale
+func BorderWidth1() BorderWidth {
+	return BorderWidth{"1"}
+}
+
+// BorderWidth2 is 2 on the BorderWidth scale
+func BorderWidth2() BorderWidth {
+	return BorderWidth{"2"}
+}
+
+// BorderWidth4 is 4 on the BorderWidth scale
+func BorderWidth4() BorderWidth {
+	return BorderWidth{"4"}
+}
+
+// BorderWidth8 is 8 on the BorderWidth scale
+func BorderWidth8() BorderWidth {
+	return BorderWidth{"8"}
+}
+
+// Radius is a value on the border radius scale. Only the values below and those themegen generates
+// for a theme are valid.
+type Radius struct{ value string }
+
+// String returns the value as it appears in class names. It panics for the
+// zero value, which is on no scale.
+func (v Radius) String() string {
+	if v.value == "" {
+		panic("css: zero Radius value")
+	}
+	return v.value
+}
+
+// Radius0 is 0 on the Radius scale
+func Radius0() Radius {
+	return Radius{"0"}
+}
+
+// Radius1 is 1 on the Radius scale
+func Radius1() Radius {
+	return Radius{"1"}
+}
+
+// Radius2 is 2 on the Radius scale
+func Radius2() Radius {
+	return Radius{"2"}
+}
+
+// Radius3 is 3 on the Radius scale
+func Radius3() Radius {
+	return Radius{"3"}
+}
+
+// Radius4 is 4 on the Radius scale
+func Radius4() Radius {
+	return Radius{"4"}
+}
+
+// Radius6 is 6 on the Radius scale
+func Radius6() Radius {
+	return Radius{"6"}
+}
+
+// Radius8 is 8 on the Radius scale
+func Radius8() Radius {
+	return Radius{"8"}
+}
+
+// Radius12 is 12 on the Radius scale
+func Radius12() Radius {
+	return Radius{"12"}
+}
+
+// Radius16 is 16 on the Radius scale
+func Radius16() Radius {
+	return Radius{"16"}
+}
+
+// Radius20 is 20 on the Radius scale
+func Radius20() Radius {
+	return Radius{"20"}
+}
+
+// Radius24 is 24 on the Radius scale
+func Radius24() Radius {
+	return Radius{"24"}
+}
+
+// RingWidth is a value on the ring width scale. Only the values below and those themegen generates
+// for a theme are valid.
+type RingWidth struct{ value string }
+
+// String returns the value as it appears in class names. It panics for the
+// zero value, which is on no scale.
+func (v RingWidth) String() string {
+	if v.value == "" {
+		panic("css: zero RingWidth value")
+	}
+	return v.value
+}
+
+// RingWidth0 is 0 on the RingWidth scale
+func RingWidth0() RingWidth {
+	return RingWidth{"0"}
+}
+
+// RingWidth1 is 1 on the RingWidth scale
+func RingWidth1() RingWidth {
+	return RingWidth{"1"}
+}
+
+// RingWidth2 is 2 on the RingWidth scale
+func RingWidth2() RingWidth {
+	return RingWidth{"2"}
+}
+
+// RingWidth4 is 4 on the RingWidth scale
+func RingWidth4() RingWidth {
+	return RingWidth{"4"}
+}
+
+// RingWidth8 is 8 on the RingWidth scale
+func RingWidth8() RingWidth {
+	return RingWidth{"8"}
+}
+
+// OutlineSize is a value on the outline width scale. Only the values below and those themegen generates
+// for a theme are valid.
+type OutlineSize struct{ value string }
+
+// String returns the value as it appears in class names. It panics for the
+// zero value, which is on no scale.
+func (v OutlineSize) String() string {
+	if v.value == "" {
+		panic("css: zero OutlineSize value")
+	}
+	return v.value
+}
+
+// OutlineSize0 is 0 on the OutlineSize scale
+func OutlineSize0() OutlineSize {
+	return OutlineSize{"0"}
+}
+
+// OutlineSize1 is 1 on the OutlineSize scale
+func OutlineSize1() OutlineSize {
+	return OutlineSize{"1"}
+}
+
+// OutlineSize2 is 2 on the OutlineSize scale
+func OutlineSize2() OutlineSize {
+	return OutlineSize{"2"}
+}
+
+// OutlineSize4 is 4 on the OutlineSize scale
+func OutlineSize4() OutlineSize {
+	return OutlineSize{"4"}
+}
+
+// OutlineSize8 is 8 on the OutlineSize scale
+func OutlineSize8() OutlineSize {
+	return OutlineSize{"8"}
+}
+
+// Width is a width. Only the values below and those themegen generates
+// for a theme are valid.
+type Width struct{ value string }
+
+// String returns the value as it appears in class names. It panics for the
+// zero value, which is on no scale.
+func (v Width) String() string {
+	if v.value == "" {
+		panic("css: zero Width value")
+	}
+	return v.value
+}
+
+// W0 is 0 on the Width scale
+func W0() Width {
+	return Width{"0"}
+}
+
+// WPx is px on the Width scale
+func WPx() Width {
+	return Width{"px"}
+}
+
+// W0_5 is 0.5 on the Width scale
+func W0_5() Width {
+	return Width{"0.5"}
+}
+
+// W1 is 1 on the Width scale
+func W1() Width {
+	return Width{"1"}
+}
+
+// W1_5 is 1.5 on the Width scale
+func W1_5() Width {
+	return Width{"1.5"}
+}
+
+// W2 is 2 on the Width scale
+func W2() Width {
+	return Width{"2"}
+}
+
+// W2_5 is 2.5 on the Width scale
+func W2_5() Width {
+	return Width{"2.5"}
+}
+
+// W3 is 3 on the Width scale
+func W3() Width {
+	return Width{"3"}
+}
+
+// W3_5 is 3.5 on the Width scale
+func W3_5() Width {
+	return Width{"3.5"}
+}
+
+// W4 is 4 on the Width scale
+func W4() Width {
+	return Width{"4"}
+}
+
+// W5 is 5 on the Width scale
+func W5() Width {
+	return Width{"5"}
+}
+
+// W6 is 6 on the Width scale
+func W6() Width {
+	return Width{"6"}
+}
+
+// W7 is 7 on the Width scale
+func W7() Width {
+	return Width{"7"}
+}
+
+// W8 is 8 on the Width scale
+func W8() Width {
+	return Width{"8"}
+}
+
+// W9 is 9 on the Width scale
+func W9() Width {
+	return Width{"9"}
+}
+
+// W10 is 10 on the Width scale
+func W10() Width {
+	return Width{"10"}
+}
+
+// W11 is 11 on the Width scale
+func W11() Width {
+	return Width{"11"}
+}
+
+// W12 is 12 on the Width scale
+func W12() Width {
+	return Width{"12"}
+}
+
+// W14 is 14 on the Width scale
+func W14() Width {
+	return Width{"14"}
+}
+
+// W16 is 16 on the Width scale
+func W16() Width {
+	return Width{"16"}
+}
+
+// W20 is 20 on the Width scale
+func W20() Width {
+	return Width{"20"}
+}
+
+// W24 is 24 on the Width scale
+func W24() Width {
+	return Width{"24"}
+}
+
+// W28 is 28 on the Width scale
+func W28() Width {
+	return Width{"28"}
+}
+
+// W32 is 32 on the Width scale
+func W32() Width {
+	return Width{"32"}
+}
+
+// W36 is 36 on the Width scale
+func W36() Width {
+	return Width{"36"}
+}
+
+// W40 is 40 on the Width scale
+func W40() Width {
+	return Width{"40"}
+}
+
+// W44 is 44 on the Width scale
+func W44() Width {
+	return Width{"44"}
+}
+
+// W48 is 48 on the Width scale
+func W48() Width {
+	return Width{"48"}
+}
+
+// W52 is 52 on the Width scale
+func W52() Width {
+	return Width{"52"}
+}
+
+// W56 is 56 on the Width scale
+func W56() Width {
+	return Width{"56"}
+}
+
+// W60 is 60 on the Width scale
+func W60() Width {
+	return Width{"60"}
+}
+
+// W64 is 64 on the Width scale
+func W64() Width {
+	return Width{"64"}
+}
+
+// W72 is 72 on the Width scale
+func W72() Width {
+	return Width{"72"}
+}
+
+// W80 is 80 on the Width scale
+func W80() Width {
+	return Width{"80"}
+}
+
+// W96 is 96 on the Width scale
+func W96() Width {
+	return Width{"96"}
+}
+
+// WAuto is auto on the Width scale
+func WAuto() Width {
+	return Width{"auto"}
+}
+
+// WFull is full on the Width scale
+func WFull() Width {
+	return Width{"full"}
+}
+
+// WScreen is screen on the Width scale
+func WScreen() Width {
+	return Width{"screen"}
+}
+
+// WMin is min on the Width scale
+func WMin() Width {
+	return Width{"min"}
+}
+
+// WMax is max on the Width scale
+func WMax() Width {
+	return Width{"max"}
+}
+
+// WFit is fit on the Width scale
+func WFit() Width {
+	return Width{"fit"}
+}
+
+// W1Of2 is 1/2 on the Width scale
+func W1Of2() Width {
+	return Width{"1/2"}
+}
+
+// W1Of3 is 1/3 on the Width scale
+func W1Of3() Width {
+	return Width{"1/3"}
+}
+
+// W2Of3 is 2/3 on the Width scale
+func W2Of3() Width {
+	return Width{"2/3"}
+}
+
+// W1Of4 is 1/4 on the Width scale
+func W1Of4() Width {
+	return Width{"1/4"}
+}
+
+// W2Of4 is 2/4 on the Width scale
+func W2Of4() Width {
+	return Width{"2/4"}
+}
+
+// W3Of4 is 3/4 on the Width scale
+func W3Of4() Width {
+	return Width{"3/4"}
+}
+
+// W1Of5 is 1/5 on the Width scale
+func W1Of5() Width {
+	return Width{"1/5"}
+}
+
+// W2Of5 is 2/5 on the Width scale
+func W2Of5() Width {
+	return Width{"2/5"}
+}
+
+// W3Of5 is 3/5 on the Width scale
+func W3Of5() Width {
+	return Width{"3/5"}
+}
+
+// W4Of5 is 4/5 on the Width scale
+func W4Of5() Width {
+	return Width{"4/5"}
+}
+
+// W1Of6 is 1/6 on the Width scale
+func W1Of6() Width {
+	return Width{"1/6"}
+}
+
+// W2Of6 is 2/6 on the Width scale
+func W2Of6() Width {
+	return Width{"2/6"}
+}
+
+// W3Of6 is 3/6 on the Width scale
+func W3Of6() Width {
+	return Width{"3/6"}
+}
+
+// W4Of6 is 4/6 on the Width scale
+func W4Of6() Width {
+	return Width{"4/6"}
+}
+
+// W5Of6 is 5/6 on the Width scale
+func W5Of6() Width {
+	return Width{"5/6"}
+}
+
+// W1Of12 is 1/12 on the Width scale
+func W1Of12() Width {
+	return Width{"1/12"}
+}
+
+// W2Of12 is 2/12 on the Width scale
+func W2Of12() Width {
+	return Width{"2/12"}
+}
+
+// W3Of12 is 3/12 on the Width scale
+func W3Of12() Width {
+	return Width{"3/12"}
+}
+
+// W4Of12 is 4/12 on the Width scale
+func W4Of12() Width {
+	return Width{"4/12"}
+}
+
+// W5Of12 is 5/12 on the Width scale
+func W5Of12() Width {
+	return Width{"5/12"}
+}
+
+// W6Of12 is 6/12 on the Width scale
+func W6Of12() Width {
+	return Width{"6/12"}
+}
+
+// W7Of12 is 7/12 on the Width scale
+func W7Of12() Width {
+	return Width{"7/12"}
+}
+
+// W8Of12 is 8/12 on the Width scale
+func W8Of12() Width {
+	return Width{"8/12"}
+}
+
+// W9Of12 is 9/12 on the Width scale
+func W9Of12() Width {
+	return Width{"9/12"}
+}
+
+// W10Of12 is 10/12 on the Width scale
+func W10Of12() Width {
+	return Width{"10/12"}
+}
+
+// W11Of12 is 11/12 on the Width scale
+func W11Of12() Width {
+	return Width{"11/12"}
+}
+
+// Height is a height. Only the values below and those themegen generates
+// for a theme are valid.
+type Height struct{ value string }
+
+// String returns the value as it appears in class names. It panics for the
+// zero value, which is on no scale.
+func (v Height) String() string {
+	if v.value == "" {
+		panic("css: zero Height value")
+	}
+	return v.value
+}
+
+// H0 is 0 on the Height scale
+func H0() Height {
+	return Height{"0"}
+}
+
+// HPx is px on the Height scale
+func HPx() Height {
+	return Height{"px"}
+}
+
+// H0_5 is 0.5 on the Height scale
+func H0_5() Height {
+	return Height{"0.5"}
+}
+
+// H1 is 1 on the Height scale
+func H1() Height {
+	return Height{"1"}
+}
+
+// H1_5 is 1.5 on the Height scale
+func H1_5() Height {
+	return Height{"1.5"}
+}
+
+// H2 is 2 on the Height scale
+func H2() Height {
+	return Height{"2"}
+}
+
+// H2_5 is 2.5 on the Height scale
+func H2_5() Height {
+	return Height{"2.5"}
+}
+
+// H3 is 3 on the Height scale
+func H3() Height {
+	return Height{"3"}
+}
+
+// H3_5 is 3.5 on the Height scale
+func H3_5() Height {
+	return Height{"3.5"}
+}
+
+// H4 is 4 on the Height scale
+func H4() Height {
+	return Height{"4"}
+}
+
+// H5 is 5 on the Height scale
+func H5() Height {
+	return Height{"5"}
+}
+
+// H6 is 6 on the Height scale
+func H6() Height {
+	return Height{"6"}
+}
+
+// H7 is 7 on the Height scale
+func H7() Height {
+	return Height{"7"}
+}
+
+// H8 is 8 on the Height scale
+func H8() Height {
+	return Height{"8"}
+}
+
+// H9 is 9 on the Height scale
+func H9() Height {
+	return Height{"9"}
+}
+
+// H10 is 10 on the Height scale
+func H10() Height {
+	return Height{"10"}
+}
+
+// H11 is 11 on the Height scale
+func H11() Height {
+	return Height{"11"}
+}
+
+// H12 is 12 on the Height scale
+func H12() Height {
+	return Height{"12"}
+}
+
+// H14 is 14 on the Height scale
+func H14() Height {
+	return Height{"14"}
+}
+
+// H16 is 16 on the Height scale
+func H16() Height {
+	return Height{"16"}
+}
+
+// H20 is 20 on the Height scale
+func H20() Height {
+	return Height{"20"}
+}
+
+// H24 is 24 on the Height scale
+func H24() Height {
+	return Height{"24"}
+}
+
+// H28 is 28 on the Height scale
+func H28() Height {
+	return Height{"28"}
+}
+
+// H32 is 32 on the Height scale
+func H32() Height {
+	return Height{"32"}
+}
+
+// H36 is 36 on the Height scale
+func H36() Height {
+	return Height{"36"}
+}
+
+// H40 is 40 on the Height scale
+func H40() Height {
+	return Height{"40"}
+}
+
+// H44 is 44 on the Height scale
+func H44() Height {
+	return Height{"44"}
+}
+
+// H48 is 48 on the Height scale
+func H48() Height {
+	return Height{"48"}
+}
+
+// H52 is 52 on the Height scale
+func H52() Height {
+	return Height{"52"}
+}
+
+// H56 is 56 on the Height scale
+func H56() Height {
+	return Height{"56"}
+}
+
+// H60 is 60 on the Height scale
+func H60() Height {
+	return Height{"60"}
+}
+
+// H64 is 64 on the Height scale
+func H64() Height {
+	return Height{"64"}
+}
+
+// H72 is 72 on the Height scale
+func H72() Height {
+	return Height{"72"}
+}
+
+// H80 is 80 on the Height scale
+func H80() Height {
+	return Height{"80"}
+}
+
+// H96 is 96 on the Height scale
+func H96() Height {
+	return Height{"96"}
+}
+
+// HAuto is auto on the Height scale
+func HAuto() Height {
+	return Height{"auto"}
+}
+
+// HFull is full on the Height scale
+func HFull() Height {
+	return Height{"full"}
+}
+
+// HScreen is screen on the Height scale
+func HScreen() Height {
+	return Height{"screen"}
+}
+
+// HMin is min on the Height scale
+func HMin() Height {
+	return Height{"min"}
+}
+
+// HMax is max on the Height scale
+func HMax() Height {
+	return Height{"max"}
+}
+
+// HFit is fit on the Height scale
+func HFit() Height {
+	return Height{"fit"}
+}
+
+// H1Of2 is 1/2 on the Height scale
+func H1Of2() Height {
+	return Height{"1/2"}
+}
+
+// H1Of3 is 1/3 on the Height scale
+func H1Of3() Height {
+	return Height{"1/3"}
+}
+
+// H2Of3 is 2/3 on the Height scale
+func H2Of3() Height {
+	return Height{"2/3"}
+}
+
+// H1Of4 is 1/4 on the Height scale
+func H1Of4() Height {
+	return Height{"1/4"}
+}
+
+// H2Of4 is 2/4 on the Height scale
+func H2Of4() Height {
+	return Height{"2/4"}
+}
+
+// H3Of4 is 3/4 on the Height scale
+func H3Of4() Height {
+	return Height{"3/4"}
+}
+
+// H1Of5 is 1/5 on the Height scale
+func H1Of5() Height {
+	return Height{"1/5"}
+}
+
+// H2Of5 is 2/5 on the Height scale
+func H2Of5() Height {
+	return Height{"2/5"}
+}
+
+// H3Of5 is 3/5 on the Height scale
+func H3Of5() Height {
+	return Height{"3/5"}
+}
+
+// H4Of5 is 4/5 on the Height scale
+func H4Of5() Height {
+	return Height{"4/5"}
+}
+
+// H1Of6 is 1/6 on the Height scale
+func H1Of6() Height {
+	return Height{"1/6"}
+}
+
+// H2Of6 is 2/6 on the Height scale
+func H2Of6() Height {
+	return Height{"2/6"}
+}
+
+// H3Of6 is 3/6 on the Height scale
+func H3Of6() Height {
+	return Height{"3/6"}
+}
+
+// H4Of6 is 4/6 on the Height scale
+func H4Of6() Height {
+	return Height{"4/6"}
+}
+
+// H5Of6 is 5/6 on the Height scale
+func H5Of6() Height {
+	return Height{"5/6"}
+}
+
+// MaxWidth is a max-width. Only the values below and those themegen generates
+// for a theme are valid.
+type MaxWidth struct{ value string }
+
+// String returns the value as it appears in class names. It panics for the
+// zero value, which is on no scale.
+func (v MaxWidth) String() string {
+	if v.value == "" {
+		panic("css: zero MaxWidth value")
+	}
+	return v.value
+}
+
+// MaxW0 is 0 on the MaxWidth scale
+func MaxW0() MaxWidth {
+	return MaxWidth{"0"}
+}
+
+// MaxWNone is none on the MaxWidth scale
+func MaxWNone() MaxWidth {
+	return MaxWidth{"none"}
+}
+
+// MaxWXs is xs on the MaxWidth scale
+func MaxWXs() MaxWidth {
+	return MaxWidth{"xs"}
+}
+
+// MaxWSm is sm on the MaxWidth scale
+func MaxWSm() MaxWidth {
+	return MaxWidth{"sm"}
+}
+
+// MaxWMd is md on the MaxWidth scale
+func MaxWMd() MaxWidth {
+	return MaxWidth{"md"}
+}
+
+// MaxWLg is lg on the MaxWidth scale
+func MaxWLg() MaxWidth {
+	return MaxWidth{"lg"}
+}
+
+// MaxWXl is xl on the MaxWidth scale
+func MaxWXl() MaxWidth {
+	return MaxWidth{"xl"}
+}
+
+// MaxW2xl is 2xl on the MaxWidth scale
+func MaxW2xl() MaxWidth {
+	return MaxWidth{"2xl"}
+}
+
+// MaxW3xl is 3xl on the MaxWidth scale
+func MaxW3xl() MaxWidth {
+	return MaxWidth{"3xl"}
+}
+
+// MaxW4xl is 4xl on the MaxWidth scale
+func MaxW4xl() MaxWidth {
+	return MaxWidth{"4xl"}
+}
+
+// MaxW5xl is 5xl on the MaxWidth scale
+func MaxW5xl() MaxWidth {
+	return MaxWidth{"5xl"}
+}
+
+// MaxW6xl is 6xl on the MaxWidth scale
+func MaxW6xl() MaxWidth {
+	return MaxWidth{"6xl"}
+}
+
+// MaxW7xl is 7xl on the MaxWidth scale
+func MaxW7xl() MaxWidth {
+	return MaxWidth{"7xl"}
+}
+
+// MaxWFull is full on the MaxWidth scale
+func MaxWFull() MaxWidth {
+	return MaxWidth{"full"}
+}
+
+// MaxWMin is min on the MaxWidth scale
+func MaxWMin() MaxWidth {
+	return MaxWidth{"min"}
+}
+
+// MaxWMax is max on the MaxWidth scale
+func MaxWMax() MaxWidth {
+	return MaxWidth{"max"}
+}
+
+// MaxWFit is fit on the MaxWidth scale
+func MaxWFit() MaxWidth {
+	return MaxWidth{"fit"}
+}
+
+// MaxWProse is prose on the MaxWidth scale
+func MaxWProse() MaxWidth {
+	return MaxWidth{"prose"}
+}
+
+// MaxWScreenSm is screen-sm on the MaxWidth scale
+func MaxWScreenSm() MaxWidth {
+	return MaxWidth{"screen-sm"}
+}
+
+// MaxWScreenMd is screen-md on the MaxWidth scale
+func MaxWScreenMd() MaxWidth {
+	return MaxWidth{"screen-md"}
+}
+
+// MaxWScreenLg is screen-lg on the MaxWidth scale
+func MaxWScreenLg() MaxWidth {
+	return MaxWidth{"screen-lg"}
+}
+
+// MaxWScreenXl is screen-xl on the MaxWidth scale
+func MaxWScreenXl() MaxWidth {
+	return MaxWidth{"screen-xl"}
+}
+
+// MaxWScreen2xl is screen-2xl on the MaxWidth scale
+func MaxWScreen2xl() MaxWidth {
+	return MaxWidth{"screen-2xl"}
+}
+
+// MinWidth is a min-width. Only the values below and those themegen generates
+// for a theme are valid.
+type MinWidth struct{ value string }
+
+// String returns the value as it appears in class names. It panics for the
+// zero value, which is on no scale.
+func (v MinWidth) String() string {
+	if v.value == "" {
+		panic("css: zero MinWidth value")
+	}
+	return v.value
+}
+
+// MinW0 is 0 on the MinWidth scale
+func MinW0() MinWidth {
+	return MinWidth{"0"}
+}
+
+// MinWFull is full on the MinWidth scale
+func MinWFull() MinWidth {
+	return MinWidth{"full"}
+}
+
+// MinWMin is min on the MinWidth scale
+func MinWMin() MinWidth {
+	return MinWidth{"min"}
+}
+
+// MinWMax is max on the MinWidth scale
+func MinWMax() MinWidth {
+	return MinWidth{"max"}
+}
+
+// MinWFit is fit on the MinWidth scale
+func MinWFit() MinWidth {
+	return MinWidth{"fit"}
+}
+
+// MaxHeight is a max-height. Only the values below and those themegen generates
+// for a theme are valid.
+type MaxHeight struct{ value string }
+
+// String returns the value as it appears in class names. It panics for the
+// zero value, which is on no scale.
+func (v MaxHeight) String() string {
+	if v.value == "" {
+		panic("css: zero MaxHeight value")
+	}
+	return v.value
+}
+
+// MaxH0 is 0 on the MaxHeight scale
+func MaxH0() MaxHeight {
+	return MaxHeight{"0"}
+}
+
+// MaxHPx is px on the MaxHeight scale
+func MaxHPx() MaxHeight {
+	return MaxHeight{"px"}
+}
+
+// MaxH1 is 1 on the MaxHeight scale
+func MaxH1() MaxHeight {
+	return MaxHeight{"1"}
+}
+
+// MaxH2 is 2 on the MaxHeight scale
+func MaxH2() MaxHeight {
+	return MaxHeight{"2"}
+}
+
+// MaxH3 is 3 on the MaxHeight scale
+func MaxH3() MaxHeight {
+	return MaxHeight{"3"}
+}
+
+// MaxH4 is 4 on the MaxHeight scale
+func MaxH4() MaxHeight {
+	return MaxHeight{"4"}
+}
+
+// MaxH5 is 5 on the MaxHeight scale
+func MaxH5() MaxHeight {
+	return MaxHeight{"5"}
+}
+
+// MaxH6 is 6 on the MaxHeight scale
+func MaxH6() MaxHeight {
+	return MaxHeight{"6"}
+}
+
+// MaxH7 is 7 on the MaxHeight scale
+func MaxH7() MaxHeight {
+	return MaxHeight{"7"}
+}
+
+// MaxH8 is 8 on the MaxHeight scale
+func MaxH8() MaxHeight {
+	return MaxHeight{"8"}
+}
+
+// MaxH9 is 9 on the MaxHeight scale
+func MaxH9() MaxHeight {
+	return MaxHeight{"9"}
+}
+
+// MaxH10 is 10 on the MaxHeight scale
+func MaxH10() MaxHeight {
+	return MaxHeight{"10"}
+}
+
+// MaxH11 is 11 on the MaxHeight scale
+func MaxH11() MaxHeight {
+	return MaxHeight{"11"}
+}
+
+// MaxH12 is 12 on the MaxHeight scale
+func MaxH12() MaxHeight {
+	return MaxHeight{"12"}
+}
+
+// MaxH14 is 14 on the MaxHeight scale
+func MaxH14() MaxHeight {
+	return MaxHeight{"14"}
+}
+
+// MaxH16 is 16 on the MaxHeight scale
+func MaxH16() MaxHeight {
+	return MaxHeight{"16"}
+}
+
+// MaxH20 is 20 on the MaxHeight scale
+func MaxH20() MaxHeight {
+	return MaxHeight{"20"}
+}
+
+// MaxH24 is 24 on the MaxHeight scale
+func MaxH24() MaxHeight {
+	return MaxHeight{"24"}
+}
+
+// MaxH28 is 28 on the MaxHeight scale
+func MaxH28() MaxHeight {
+	return MaxHeight{"28"}
+}
+
+// MaxH32 is 32 on the MaxHeight scale
+func MaxH32() MaxHeight {
+	return MaxHeight{"32"}
+}
+
+// MaxH36 is 36 on the MaxHeight scale
+func MaxH36() MaxHeight {
+	return MaxHeight{"36"}
+}
+
+// MaxH40 is 40 on the MaxHeight scale
+func MaxH40() MaxHeight {
+	return MaxHeight{"40"}
+}
+
+// MaxH44 is 44 on the MaxHeight scale
+func MaxH44() MaxHeight {
+	return MaxHeight{"44"}
+}
+
+// MaxH48 is 48 on the MaxHeight scale
+func MaxH48() MaxHeight {
+	return MaxHeight{"48"}
+}
+
+// MaxH52 is 52 on the MaxHeight scale
+func MaxH52() MaxHeight {
+	return MaxHeight{"52"}
+}
+
+// MaxH56 is 56 on the MaxHeight scale
+func MaxH56() MaxHeight {
+	return MaxHeight{"56"}
+}
+
+// MaxH60 is 60 on the MaxHeight scale
+func MaxH60() MaxHeight {
+	return MaxHeight{"60"}
+}
+
+// MaxH64 is 64 on the MaxHeight scale
+func MaxH64() MaxHeight {
+	return MaxHeight{"64"}
+}
+
+// MaxH72 is 72 on the MaxHeight scale
+func MaxH72() MaxHeight {
+	return MaxHeight{"72"}
+}
+
+// MaxH80 is 80 on the MaxHeight scale
+func MaxH80() MaxHeight {
+	return MaxHeight{"80"}
+}
+
+// MaxH96 is 96 on the MaxHeight scale
+func MaxH96() MaxHeight {
+	return MaxHeight{"96"}
+}
+
+// MaxHFull is full on the MaxHeight scale
+func MaxHFull() MaxHeight {
+	return MaxHeight{"full"}
+}
+
+// MaxHScreen is screen on the MaxHeight scale
+func MaxHScreen() MaxHeight {
+	return MaxHeight{"screen"}
+}
+
+// MaxHMin is min on the MaxHeight scale
+func MaxHMin() MaxHeight {
+	return MaxHeight{"min"}
+}
+
+// MaxHMax is max on the MaxHeight scale
+func MaxHMax() MaxHeight {
+	return MaxHeight{"max"}
+}
+
+// MaxHFit is fit on the MaxHeight scale
+func MaxHFit() MaxHeight {
+	return MaxHeight{"fit"}
+}
+
+// MaxHNone is none on the MaxHeight scale
+func MaxHNone() MaxHeight {
+	return MaxHeight{"none"}
+}
+
+// MinHeight is a min-height. Only the values below and those themegen generates
+// for a theme are valid.
+type MinHeight struct{ value string }
+
+// String returns the value as it appears in class names. It panics for the
+// zero value, which is on no scale.
+func (v MinHeight) String() string {
+	if v.value == "" {
+		panic("css: zero MinHeight value")
+	}
+	return v.value
+}
+
+// MinH0 is 0 on the MinHeight scale
+func MinH0() MinHeight {
+	return MinHeight{"0"}
+}
+
+// MinHFull is full on the MinHeight scale
+func MinHFull() MinHeight {
+	return MinHeight{"full"}
+}
+
+// MinHScreen is screen on the MinHeight scale
+func MinHScreen() MinHeight {
+	return MinHeight{"screen"}
+}
+
+// MinHMin is min on the MinHeight scale
+func MinHMin() MinHeight {
+	return MinHeight{"min"}
+}
+
+// MinHMax is max on the MinHeight scale
+func MinHMax() MinHeight {
+	return MinHeight{"max"}
+}
+
+// MinHFit is fit on the MinHeight scale
+func MinHFit() MinHeight {
+	return MinHeight{"fit"}
+}
+
+// Offset is a top, right, bottom, left or inset value. Only the values below and those themegen generates
+// for a theme are valid.
+type Offset struct{ value string }
+
+// String returns the value as it appears in class names. It panics for the
+// zero value, which is on no scale.
+func (v Offset) String() string {
+	if v.value == "" {
+		panic("css: zero Offset value")
+	}
+	return v.value
+}
+
+// Offset0 is 0 on the Offset scale
+func Offset0() Offset {
+	return Offset{"0"}
+}
+
+// OffsetPx is px on the Offset scale
+func OffsetPx() Offset {
+	return Offset{"px"}
+}
+
+// Offset0_5 is 0.5 on the Offset scale
+func Offset0_5() Offset {
+	return Offset{"0.5"}
+}
+
+// Offset1 is 1 on the Offset scale
+func Offset1() Offset {
+	return Offset{"1"}
+}
+
+// Offset1_5 is 1.5 on the Offset scale
+func Offset1_5() Offset {
+	return Offset{"1.5"}
+}
+
+// Offset2 is 2 on the Offset scale
+func Offset2() Offset {
+	return Offset{"2"}
+}
+
+// Offset2_5 is 2.5 on the Offset scale
+func Offset2_5() Offset {
+	return Offset{"2.5"}
+}
+
+// Offset3 is 3 on the Offset scale
+func Offset3() Offset {
+	return Offset{"3"}
+}
+
+// Offset3_5 is 3.5 on the Offset scale
+func Offset3_5() Offset {
+	return Offset{"3.5"}
+}
+
+// Offset4 is 4 on the Offset scale
+func Offset4() Offset {
+	return Offset{"4"}
+}
+
+// Offset5 is 5 on the Offset scale
+func Offset5() Offset {
+	return Offset{"5"}
+}
+
+// Offset6 is 6 on the Offset scale
+func Offset6() Offset {
+	return Offset{"6"}
+}
+
+// Offset7 is 7 on the Offset scale
+func Offset7() Offset {
+	return Offset{"7"}
+}
+
+// Offset8 is 8 on the Offset scale
+func Offset8() Offset {
+	return Offset{"8"}
+}
+
+// Offset9 is 9 on the Offset scale
+func Offset9() Offset {
+	return Offset{"9"}
+}
+
+// Offset10 is 10 on the Offset scale
+func Offset10() Offset {
+	return Offset{"10"}
+}
+
+// Offset11 is 11 on the Offset scale
+func Offset11() Offset {
+	return Offset{"11"}
+}
+
+// Offset12 is 12 on the Offset scale
+func Offset12() Offset {
+	return Offset{"12"}
+}
+
+// Offset14 is 14 on the Offset scale
+func Offset14() Offset {
+	return Offset{"14"}
+}
+
+// Offset16 is 16 on the Offset scale
+func Offset16() Offset {
+	return Offset{"16"}
+}
+
+// Offset20 is 20 on the Offset scale
+func Offset20() Offset {
+	return Offset{"20"}
+}
+
+// Offset24 is 24 on the Offset scale
+func Offset24() Offset {
+	return Offset{"24"}
+}
+
+// Offset28 is 28 on the Offset scale
+func Offset28() Offset {
+	return Offset{"28"}
+}
+
+// Offset32 is 32 on the Offset scale
+func Offset32() Offset {
+	return Offset{"32"}
+}
+
+// Offset36 is 36 on the Offset scale
+func Offset36() Offset {
+	return Offset{"36"}
+}
+
+// Offset40 is 40 on the Offset scale
+func Offset40() Offset {
+	return Offset{"40"}
+}
+
+// Offset44 is 44 on the Offset scale
+func Offset44() Offset {
+	return Offset{"44"}
+}
+
+// Offset48 is 48 on the Offset scale
+func Offset48() Offset {
+	return Offset{"48"}
+}
+
+// Offset52 is 52 on the Offset scale
+func Offset52() Offset {
+	return Offset{"52"}
+}
+
+// Offset56 is 56 on the Offset scale
+func Offset56() Offset {
+	return Offset{"56"}
+}
+
+// Offset60 is 60 on the Offset scale
+func Offset60() Offset {
+	return Offset{"60"}
+}
+
+// Offset64 is 64 on the Offset scale
+func Offset64() Offset {
+	return Offset{"64"}
+}
+
+// Offset72 is 72 on the Offset scale
+func Offset72() Offset {
+	return Offset{"72"}
+}
+
+// Offset80 is 80 on the Offset scale
+func Offset80() Offset {
+	return Offset{"80"}
+}
+
+// Offset96 is 96 on the Offset scale
+func Offset96() Offset {
+	return Offset{"96"}
+}
+
+// OffsetAuto is auto on the Offset scale
+func OffsetAuto() Offset {
+	return Offset{"auto"}
+}
+
+// OffsetFull is full on the Offset scale
+func OffsetFull() Offset {
+	return Offset{"full"}
+}
+
+// Offset1Of2 is 1/2 on the Offset scale
+func Offset1Of2() Offset {
+	return Offset{"1/2"}
+}
+
+// Offset1Of3 is 1/3 on the Offset scale
+func Offset1Of3() Offset {
+	return Offset{"1/3"}
+}
+
+// Offset2Of3 is 2/3 on the Offset scale
+func Offset2Of3() Offset {
+	return Offset{"2/3"}
+}
+
+// Offset1Of4 is 1/4 on the Offset scale
+func Offset1Of4() Offset {
+	return Offset{"1/4"}
+}
+
+// Offset2Of4 is 2/4 on the Offset scale
+func Offset2Of4() Offset {
+	return Offset{"2/4"}
+}
+
+// Offset3Of4 is 3/4 on the Offset scale
+func Offset3Of4() Offset {
+	return Offset{"3/4"}
+}
+
+// ZIndex is a z-index. Only the values below and those themegen generates
+// for a theme are valid.
+type ZIndex struct{ value string }
+
+// String returns the value as it appears in class names. It panics for the
+// zero value, which is on no scale.
+func (v ZIndex) String() string {
+	if v.value == "" {
+		panic("css: zero ZIndex value")
+	}
+	return v.value
+}
+
+// Z0 is 0 on the ZIndex scale
+func Z0() ZIndex {
+	return ZIndex{"0"}
+}
+
+// Z10 is 10 on the ZIndex scale
+func Z10() ZIndex {
+	return ZIndex{"10"}
+}
+
+// Z20 is 20 on the ZIndex scale
+func Z20() ZIndex {
+	return ZIndex{"20"}
+}
+
+// Z30 is 30 on the ZIndex scale
+func Z30() ZIndex {
+	return ZIndex{"30"}
+}
+
+// Z40 is 40 on the ZIndex scale
+func Z40() ZIndex {
+	return ZIndex{"40"}
+}
+
+// Z50 is 50 on the ZIndex scale
+func Z50() ZIndex {
+	return ZIndex{"50"}
+}
+
+// ZAuto is auto on the ZIndex scale
+func ZAuto() ZIndex {
+	return ZIndex{"auto"}
+}
+
+// OpacityLevel is a value on the opacity scale. Only the values below and those themegen generates
+// for a theme are valid.
+type OpacityLevel struct{ value string }
+
+// String returns the value as it appears in class names. It panics for the
+// zero value, which is on no scale.
+func (v OpacityLevel) String() string {
+	if v.value == "" {
+		panic("css: zero OpacityLevel value")
+	}
+	return v.value
+}
+
+// Opacity0 is 0 on the OpacityLevel scale
+func Opacity0() OpacityLevel {
+	return OpacityLevel{"0"}
+}
+
+// Opacity5 is 5 on the OpacityLevel scale
+func Opacity5() OpacityLevel {
+	return OpacityLevel{"5"}
+}
+
+// Opacity10 is 10 on the OpacityLevel scale
+func Opacity10() OpacityLevel {
+	return OpacityLevel{"10"}
+}
+
+// Opacity20 is 20 on the OpacityLevel scale
+func Opacity20() OpacityLevel {
+	return OpacityLevel{"20"}
+}
+
+// Opacity25 is 25 on the OpacityLevel scale
+func Opacity25() OpacityLevel {
+	return OpacityLevel{"25"}
+}
+
+// Opacity30 is 30 on the OpacityLevel scale
+func Opacity30() OpacityLevel {
+	return OpacityLevel{"30"}
+}
+
+// Opacity40 is 40 on the OpacityLevel scale
+func Opacity40() OpacityLevel {
+	return OpacityLevel{"40"}
+}
+
+// Opacity50 is 50 on the OpacityLevel scale
+func Opacity50() OpacityLevel {
+	return OpacityLevel{"50"}
+}
+
+// Opacity60 is 60 on the OpacityLevel scale
+func Opacity60() OpacityLevel {
+	return OpacityLevel{"60"}
+}
+
+// Opacity70 is 70 on the OpacityLevel scale
+func Opacity70() OpacityLevel {
+	return OpacityLevel{"70"}
+}
+
+// Opacity75 is 75 on the OpacityLevel scale
+func Opacity75() OpacityLevel {
+	return OpacityLevel{"75"}
+}
+
+// Opacity80 is 80 on the OpacityLevel scale
+func Opacity80() OpacityLevel {
+	return OpacityLevel{"80"}
+}
+
+// Opacity90 is 90 on the OpacityLevel scale
+func Opacity90() OpacityLevel {
+	return OpacityLevel{"90"}
+}
+
+// Opacity95 is 95 on the OpacityLevel scale
+func Opacity95() OpacityLevel {
+	return OpacityLevel{"95"}
+}
+
+// Opacity100 is 100 on the OpacityLevel scale
+func Opacity100() OpacityLevel {
+	return OpacityLevel{"100"}
+}
+
+// ShadowSize is a box shadow size. Only the values below and those themegen generates
+// for a theme are valid.
+type ShadowSize struct{ value string }
+
+// String returns the value as it appears in class names. It panics for the
+// zero value, which is on no scale.
+func (v ShadowSize) String() string {
+	if v.value == "" {
+		panic("css: zero ShadowSize value")
+	}
+	return v.value
+}
+
+// ShadowSm is sm on the ShadowSize scale
+func ShadowSm() ShadowSize {
+	return ShadowSize{"sm"}
+}
+
+// ShadowMd is md on the ShadowSize scale
+func ShadowMd() ShadowSize {
+	return ShadowSize{"md"}
+}
+
+// ShadowLg is lg on the ShadowSize scale
+func ShadowLg() ShadowSize {
+	return ShadowSize{"lg"}
+}
+
+// ShadowXl is xl on the ShadowSize scale
+func ShadowXl() ShadowSize {
+	return ShadowSize{"xl"}
+}
+
+// Shadow2xl is 2xl on the ShadowSize scale
+func Shadow2xl() ShadowSize {
+	return ShadowSize{"2xl"}
+}
+
+// ShadowInner is inner on the ShadowSize scale
+func ShadowInner() ShadowSize {
+	return ShadowSize{"inner"}
+}
+
+// ShadowNone is none on the ShadowSize scale
+func ShadowNone() ShadowSize {
+	return ShadowSize{"none"}
+}
+
+// BlurSize is a blur filter value. Only the values below and those themegen generates
+// for a theme are valid.
+type BlurSize struct{ value string }
+
+// String returns the value as it appears in class names. It panics for the
+// zero value, which is on no scale.
+func (v BlurSize) String() string {
+	if v.value == "" {
+		panic("css: zero BlurSize value")
+	}
+	return v.value
+}
+
+// BlurNone is none on the BlurSize scale
+func BlurNone() BlurSize {
+	return BlurSize{"none"}
+}
+
+// BlurSm is sm on the BlurSize scale
+func BlurSm() BlurSize {
+	return BlurSize{"sm"}
+}
+
+// BlurMd is md on the BlurSize scale
+func BlurMd() BlurSize {
+	return BlurSize{"md"}
+}
+
+// BlurLg is lg on the BlurSize scale
+func BlurLg() BlurSize {
+	return BlurSize{"lg"}
+}
+
+// BlurXl is xl on the BlurSize scale
+func BlurXl() BlurSize {
+	return BlurSize{"xl"}
+}
+
+// Blur2xl is 2xl on the BlurSize scale
+func Blur2xl() BlurSize {
+	return BlurSize{"2xl"}
+}
+
+// Blur3xl is 3xl on the BlurSize scale
+func Blur3xl() BlurSize {
+	return BlurSize{"3xl"}
+}
+
+// BrightnessLevel is a brightness filter value. Only the values below and those themegen generates
+// for a theme are valid.
+type BrightnessLevel struct{ value string }
+
+// String returns the value as it appears in class names. It panics for the
+// zero value, which is on no scale.
+func (v BrightnessLevel) String() string {
+	if v.value == "" {
+		panic("css: zero BrightnessLevel value")
+	}
+	return v.value
+}
+
+// Brightness0 is 0 on the BrightnessLevel scale
+func Brightness0() BrightnessLevel {
+	return BrightnessLevel{"0"}
+}
+
+// Brightness50 is 50 on the BrightnessLevel scale
+func Brightness50() BrightnessLevel {
+	return BrightnessLevel{"50"}
+}
+
+// Brightness75 is 75 on the BrightnessLevel scale
+func Brightness75() BrightnessLevel {
+	return BrightnessLevel{"75"}
+}
+
+// Brightness90 is 90 on the BrightnessLevel scale
+func Brightness90() BrightnessLevel {
+	return BrightnessLevel{"90"}
+}
+
+// Brightness95 is 95 on the BrightnessLevel scale
+func Brightness95() BrightnessLevel {
+	return BrightnessLevel{"95"}
+}
+
+// Brightness100 is 100 on the BrightnessLevel scale
+func Brightness100() BrightnessLevel {
+	return BrightnessLevel{"100"}
+}
+
+// Brightness105 is 105 on the BrightnessLevel scale
+func Brightness105() BrightnessLevel {
+	return BrightnessLevel{"105"}
+}
+
+// Brightness110 is 110 on the BrightnessLevel scale
+func Brightness110() BrightnessLevel {
+	return BrightnessLevel{"110"}
+}
+
+// Brightness125 is 125 on the BrightnessLevel scale
+func Brightness125() BrightnessLevel {
+	return BrightnessLevel{"125"}
+}
+
+// Brightness150 is 150 on the BrightnessLevel scale
+func Brightness150() BrightnessLevel {
+	return BrightnessLevel{"150"}
+}
+
+// Brightness200 is 200 on the BrightnessLevel scale
+func Brightness200() BrightnessLevel {
+	return BrightnessLevel{"200"}
+}
+
+// ContrastLevel is a contrast filter value. Only the values below and those themegen generates
+// for a theme are valid.
+type ContrastLevel struct{ value string }
+
+// String returns the value as it appears in class names. It panics for the
+// zero value, which is on no scale.
+func (v ContrastLevel) String() string {
+	if v.value == "" {
+		panic("css: zero ContrastLevel value")
+	}
+	return v.value
+}
+
+// Contrast0 is 0 on the ContrastLevel scale
+func Contrast0() ContrastLevel {
+	return ContrastLevel{"0"}
+}
+
+// Contrast50 is 50 on the ContrastLevel scale
+func Contrast50() ContrastLevel {
+	return ContrastLevel{"50"}
+}
+
+// Contrast75 is 75 on the ContrastLevel scale
+func Contrast75() ContrastLevel {
+	return ContrastLevel{"75"}
+}
+
+// Contrast100 is 100 on the ContrastLevel scale
+func Contrast100() ContrastLevel {
+	return ContrastLevel{"100"}
+}
+
+// Contrast125 is 125 on the ContrastLevel scale
+func Contrast125() ContrastLevel {
+	return ContrastLevel{"125"}
+}
+
+// Contrast150 is 150 on the ContrastLevel scale
+func Contrast150() ContrastLevel {
+	return ContrastLevel{"150"}
+}
+
+// Contrast200 is 200 on the ContrastLevel scale
+func Contrast200() ContrastLevel {
+	return ContrastLevel{"200"}
+}
+
+// GrayscaleLevel is a grayscale filter value. Only the values below and those themegen generates
+// for a theme are valid.
+type GrayscaleLevel struct{ value string }
+
+// String returns the value as it appears in class names. It panics for the
+// zero value, which is on no scale.
+func (v GrayscaleLevel) String() string {
+	if v.value == "" {
+		panic("css: zero GrayscaleLevel value")
+	}
+	return v.value
+}
+
+// Grayscale0 is 0 on the GrayscaleLevel scale
+func Grayscale0() GrayscaleLevel {
+	return GrayscaleLevel{"0"}
+}
+
+// DropShadowSize is a drop-shadow filter value. Only the values below and those themegen generates
+// for a theme are valid.
+type DropShadowSize struct{ value string }
+
+// String returns the value as it appears in class names. It panics for the
+// zero value, which is on no scale.
+func (v DropShadowSize) String() string {
+	if v.value == "" {
+		panic("css: zero DropShadowSize value")
+	}
+	return v.value
+}
+
+// DropShadowSm is sm on the DropShadowSize scale
+func DropShadowSm() DropShadowSize {
+	return DropShadowSize{"sm"}
+}
+
+// DropShadowMd is md on the DropShadowSize scale
+func DropShadowMd() DropShadowSize {
+	return DropShadowSize{"md"}
+}
+
+// DropShadowLg is lg on the DropShadowSize scale
+func DropShadowLg() DropShadowSize {
+	return DropShadowSize{"lg"}
+}
+
+// DropShadowXl is xl on the DropShadowSize scale
+func DropShadowXl() DropShadowSize {
+	return DropShadowSize{"xl"}
+}
+
+// DropShadow2xl is 2xl on the DropShadowSize scale
+func DropShadow2xl() DropShadowSize {
+	return DropShadowSize{"2xl"}
+}
+
+// DropShadowNone is none on the DropShadowSize scale
+func DropShadowNone() DropShadowSize {
+	return DropShadowSize{"none"}
+}
+
+// ScaleFactor is a scale percentage. Only the values below and those themegen generates
+// for a theme are valid.
+type ScaleFactor struct{ value string }
+
+// String returns the value as it appears in class names. It panics for the
+// zero value, which is on no scale.
+func (v ScaleFactor) String() string {
+	if v.value == "" {
+		panic("css: zero ScaleFactor value")
+	}
+	return v.value
+}
+
+// Scale0 is 0 on the ScaleFactor scale
+func Scale0() ScaleFactor {
+	return ScaleFactor{"0"}
+}
+
+// Scale50 is 50 on the ScaleFactor scale
+func Scale50() ScaleFactor {
+	return ScaleFactor{"50"}
+}
+
+// Scale75 is 75 on the ScaleFactor scale
+func Scale75() ScaleFactor {
+	return ScaleFactor{"75"}
+}
+
+// Scale90 is 90 on the ScaleFactor scale
+func Scale90() ScaleFactor {
+	return ScaleFactor{"90"}
+}
+
+// Scale95 is 95 on the ScaleFactor scale
+func Scale95() ScaleFactor {
+	return ScaleFactor{"95"}
+}
+
+// Scale100 is 100 on the ScaleFactor scale
+func Scale100() ScaleFactor {
+	return ScaleFactor{"100"}
+}
+
+// Scale105 is 105 on the ScaleFactor scale
+func Scale105() ScaleFactor {
+	return ScaleFactor{"105"}
+}
+
+// Scale110 is 110 on the ScaleFactor scale
+func Scale110() ScaleFactor {
+	return ScaleFactor{"110"}
+}
+
+// Scale125 is 125 on the ScaleFactor scale
+func Scale125() ScaleFactor {
+	return ScaleFactor{"125"}
+}
+
+// Scale150 is 150 on the ScaleFactor scale
+func Scale150() ScaleFactor {
+	return ScaleFactor{"150"}
+}
+
+// Rotation is a rotation in degrees. Only the values below and those themegen generates
+// for a theme are valid.
+type Rotation struct{ value string }
+
+// String returns the value as it appears in class names. It panics for the
+// zero value, which is on no scale.
+func (v Rotation) String() string {
+	if v.value == "" {
+		panic("css: zero Rotation value")
+	}
+	return v.value
+}
+
+// Rotate0 is 0 on the Rotation scale
+func Rotate0() Rotation {
+	return Rotation{"0"}
+}
+
+// Rotate1 is 1 on the Rotation scale
+func Rotate1() Rotation {
+	return Rotation{"1"}
+}
+
+// Rotate2 is 2 on the Rotation scale
+func Rotate2() Rotation {
+	return Rotation{"2"}
+}
+
+// Rotate3 is 3 on the Rotation scale
+func Rotate3() Rotation {
+	return Rotation{"3"}
+}
+
+// Rotate6 is 6 on the Rotation scale
+func Rotate6() Rotation {
+	return Rotation{"6"}
+}
+
+// Rotate12 is 12 on the Rotation scale
+func Rotate12() Rotation {
+	return Rotation{"12"}
+}
+
+// Rotate45 is 45 on the Rotation scale
+func Rotate45() Rotation {
+	return Rotation{"45"}
+}
+
+// Rotate90 is 90 on the Rotation scale
+func Rotate90() Rotation {
+	return Rotation{"90"}
+}
+
+// Rotate180 is 180 on the Rotation scale
+func Rotate180() Rotation {
+	return Rotation{"180"}
+}
+
+// SkewAngle is a skew angle in degrees. Only the values below and those themegen generates
+// for a theme are valid.
+type SkewAngle struct{ value string }
+
+// String returns the value as it appears in class names. It panics for the
+// zero value, which is on no scale.
+func (v SkewAngle) String() string {
+	if v.value == "" {
+		panic("css: zero SkewAngle value")
+	}
+	return v.value
+}
+
+// Skew0 is 0 on the SkewAngle scale
+func Skew0() SkewAngle {
+	return SkewAngle{"0"}
+}
+
+// Skew1 is 1 on the SkewAngle scale
+func Skew1() SkewAngle {
+	return SkewAngle{"1"}
+}
+
+// Skew2 is 2 on the SkewAngle scale
+func Skew2() SkewAngle {
+	return SkewAngle{"2"}
+}
+
+// Skew3 is 3 on the SkewAngle scale
+func Skew3() SkewAngle {
+	return SkewAngle{"3"}
+}
+
+// Skew6 is 6 on the SkewAngle scale
+func Skew6() SkewAngle {
+	return SkewAngle{"6"}
+}
+
+// Skew12 is 12 on the SkewAngle scale
+func Skew12() SkewAngle {
+	return SkewAngle{"12"}
+}
+
+// Translation is a translate distance from the spacing scale or a fraction. Only the values below and those themegen generates
+// for a theme are valid.
+type Translation struct{ value string }
+
+// String returns the value as it appears in class names. It panics for the
+// zero value, which is on no scale.
+func (v Translation) String() string {
+	if v.value == "" {
+		panic("css: zero Translation value")
+	}
+	return v.value
+}
+
+// Translate0 is 0 on the Translation scale
+func Translate0() Translation {
+	return Translation{"0"}
+}
+
+// Translate1 is 1 on the Translation scale
+func Translate1() Translation {
+	return Translation{"1"}
+}
+
+// Translate2 is 2 on the Translation scale
+func Translate2() Translation {
+	return Translation{"2"}
+}
+
+// Translate3 is 3 on the Translation scale
+func Translate3() Translation {
+	return Translation{"3"}
+}
+
+// Translate4 is 4 on the Translation scale
+func Translate4() Translation {
+	return Translation{"4"}
+}
+
+// Translate5 is 5 on the Translation scale
+func Translate5() Translation {
+	return Translation{"5"}
+}
+
+// Translate6 is 6 on the Translation scale
+func Translate6() Translation {
+	return Translation{"6"}
+}
+
+// Translate7 is 7 on the Translation scale
+func Translate7() Translation {
+	return Translation{"7"}
+}
+
+// Translate8 is 8 on the Translation scale
+func Translate8() Translation {
+	return Translation{"8"}
+}
+
+// Translate9 is 9 on the Translation scale
+func Translate9() Translation {
+	return Translation{"9"}
+}
+
+// Translate10 is 10 on the Translation scale
+func Translate10() Translation {
+	return Translation{"10"}
+}
+
+// Translate11 is 11 on the Translation scale
+func Translate11() Translation {
+	return Translation{"11"}
+}
+
+// Translate12 is 12 on the Translation scale
+func Translate12() Translation {
+	return Translation{"12"}
+}
+
+// Translate14 is 14 on the Translation scale
+func Translate14() Translation {
+	return Translation{"14"}
+}
+
+// Translate16 is 16 on the Translation scale
+func Translate16() Translation {
+	return Translation{"16"}
+}
+
+// Translate20 is 20 on the Translation scale
+func Translate20() Translation {
+	return Translation{"20"}
+}
+
+// Translate24 is 24 on the Translation scale
+func Translate24() Translation {
+	return Translation{"24"}
+}
+
+// Translate28 is 28 on the Translation scale
+func Translate28() Translation {
+	return Translation{"28"}
+}
+
+// Translate32 is 32 on the Translation scale
+func Translate32() Translation {
+	return Translation{"32"}
+}
+
+// Translate36 is 36 on the Translation scale
+func Translate36() Translation {
+	return Translation{"36"}
+}
+
+// Translate40 is 40 on the Translation scale
+func Translate40() Translation {
+	return Translation{"40"}
+}
+
+// Translate44 is 44 on the Translation scale
+func Translate44() Translation {
+	return Translation{"44"}
+}
+
+// Translate48 is 48 on the Translation scale
+func Translate48() Translation {
+	return Translation{"48"}
+}
+
+// Translate52 is 52 on the Translation scale
+func Translate52() Translation {
+	return Translation{"52"}
+}
+
+// Translate56 is 56 on the Translation scale
+func Translate56() Translation {
+	return Translation{"56"}
+}
+
+// Translate60 is 60 on the Translation scale
+func Translate60() Translation {
+	return Translation{"60"}
+}
+
+// Translate64 is 64 on the Translation scale
+func Translate64() Translation {
+	return Translation{"64"}
+}
+
+// Translate72 is 72 on the Translation scale
+func Translate72() Translation {
+	return Translation{"72"}
+}
+
+// Translate80 is 80 on the Translation scale
+func Translate80() Translation {
+	return Translation{"80"}
+}
+
+// Translate96 is 96 on the Translation scale
+func Translate96() Translation {
+	return Translation{"96"}
+}
+
+// Translate1Of2 is 1/2 on the Translation scale
+func Translate1Of2() Translation {
+	return Translation{"1/2"}
+}
+
+// Translate1Of3 is 1/3 on the Translation scale
+func Translate1Of3() Translation {
+	return Translation{"1/3"}
+}
+
+// Translate2Of3 is 2/3 on the Translation scale
+func Translate2Of3() Translation {
+	return Translation{"2/3"}
+}
+
+// Translate1Of4 is 1/4 on the Translation scale
+func Translate1Of4() Translation {
+	return Translation{"1/4"}
+}
+
+// Translate3Of4 is 3/4 on the Translation scale
+func Translate3Of4() Translation {
+	return Translation{"3/4"}
+}
+
+// TranslateFull is full on the Translation scale
+func TranslateFull() Translation {
+	return Translation{"full"}
+}
+
+// Milliseconds is a transition time in milliseconds. Only the values below and those themegen generates
+// for a theme are valid.
+type Milliseconds struct{ value string }
+
+// String returns the value as it appears in class names. It panics for the
+// zero value, which is on no scale.
+func (v Milliseconds) String() string {
+	if v.value == "" {
+		panic("css: zero Milliseconds value")
+	}
+	return v.value
+}
+
+// Ms0 is 0 on the Milliseconds scale
+func Ms0() Milliseconds {
+	return Milliseconds{"0"}
+}
+
+// Ms75 is 75 on the Milliseconds scale
+func Ms75() Milliseconds {
+	return Milliseconds{"75"}
+}
+
+// Ms100 is 100 on the Milliseconds scale
+func Ms100() Milliseconds {
+	return Milliseconds{"100"}
+}
+
+// Ms150 is 150 on the Milliseconds scale
+func Ms150() Milliseconds {
+	return Milliseconds{"150"}
+}
+
+// Ms200 is 200 on the Milliseconds scale
+func Ms200() Milliseconds {
+	return Milliseconds{"200"}
+}
+
+// Ms300 is 300 on the Milliseconds scale
+func Ms300() Milliseconds {
+	return Milliseconds{"300"}
+}
+
+// Ms500 is 500 on the Milliseconds scale
+func Ms500() Milliseconds {
+	return Milliseconds{"500"}
+}
+
+// Ms700 is 700 on the Milliseconds scale
+func Ms700() Milliseconds {
+	return Milliseconds{"700"}
+}
+
+// Ms1000 is 1000 on the Milliseconds scale
+func Ms1000() Milliseconds {
+	return Milliseconds{"1000"}
+}
+
+// P applies padding utility
+func P(size Spacing) Class {
+	className := "p-" + size.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// Px applies padding-x utility
+func Px(size Spacing) Class {
+	className := "px-" + size.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// Py applies padding-y utility
+func Py(size Spacing) Class {
+	className := "py-" + size.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// Pt applies padding-top utility
+func Pt(size Spacing) Class {
+	className := "pt-" + size.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// Pr applies padding-right utility
+func Pr(size Spacing) Class {
+	className := "pr-" + size.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// Pb applies padding-bottom utility
+func Pb(size Spacing) Class {
+	className := "pb-" + size.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// Pl applies padding-left utility
+func Pl(size Spacing) Class {
+	className := "pl-" + size.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// M applies margin utility
+func M(size Spacing) Class {
+	className := "m-" + size.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// Mx applies margin-x utility
+func Mx(size Spacing) Class {
+	className := "mx-" + size.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// My applies margin-y utility
+func My(size Spacing) Class {
+	className := "my-" + size.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// Mt applies margin-top utility
+func Mt(size Spacing) Class {
+	className := "mt-" + size.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// Mr applies margin-right utility
+func Mr(size Spacing) Class {
+	className := "mr-" + size.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// Mb applies margin-bottom utility
+func Mb(size Spacing) Class {
+	className := "mb-" + size.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// Ml applies margin-left utility
+func Ml(size Spacing) Class {
+	className := "ml-" + size.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// SpaceX applies space-x utility
+func SpaceX(size Spacing) Class {
+	className := "space-x-" + size.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// SpaceY applies space-y utility
+func SpaceY(size Spacing) Class {
+	className := "space-y-" + size.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BgGradientToT applies bg-gradient-to-t utility
+func BgGradientToT() Class {
+	trackClass("bg-gradient-to-t")
+	return "bg-gradient-to-t"
+}
+
+// BgGradientToTr applies bg-gradient-to-tr utility
+func BgGradientToTr() Class {
+	trackClass("bg-gradient-to-tr")
+	return "bg-gradient-to-tr"
+}
+
+// BgGradientToR applies bg-gradient-to-r utility
+func BgGradientToR() Class {
+	trackClass("bg-gradient-to-r")
+	return "bg-gradient-to-r"
+}
+
+// BgGradientToBr applies bg-gradient-to-br utility
+func BgGradientToBr() Class {
+	trackClass("bg-gradient-to-br")
+	return "bg-gradient-to-br"
+}
+
+// BgGradientToB applies bg-gradient-to-b utility
+func BgGradientToB() Class {
+	trackClass("bg-gradient-to-b")
+	return "bg-gradient-to-b"
+}
+
+// BgGradientToBl applies bg-gradient-to-bl utility
+func BgGradientToBl() Class {
+	trackClass("bg-gradient-to-bl")
+	return "bg-gradient-to-bl"
+}
+
+// BgGradientToL applies bg-gradient-to-l utility
+func BgGradientToL() Class {
+	trackClass("bg-gradient-to-l")
+	return "bg-gradient-to-l"
+}
+
+// BgGradientToTl applies bg-gradient-to-tl utility
+func BgGradientToTl() Class {
+	trackClass("bg-gradient-to-tl")
+	return "bg-gradient-to-tl"
+}
+
+// BgAmber applies bg-amber-shade utility
+func BgAmber(shade Shade) Class {
+	className := "bg-amber-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// TextAmber applies text-amber-shade utility
+func TextAmber(shade Shade) Class {
+	className := "text-amber-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderAmber applies border-amber-shade utility
+func BorderAmber(shade Shade) Class {
+	className := "border-amber-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderTAmber applies border-t-amber-shade utility
+func BorderTAmber(shade Shade) Class {
+	className := "border-t-amber-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderRAmber applies border-r-amber-shade utility
+func BorderRAmber(shade Shade) Class {
+	className := "border-r-amber-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderBAmber applies border-b-amber-shade utility
+func BorderBAmber(shade Shade) Class {
+	className := "border-b-amber-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderLAmber applies border-l-amber-shade utility
+func BorderLAmber(shade Shade) Class {
+	className := "border-l-amber-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// DivideAmber applies divide-amber-shade utility
+func DivideAmber(shade Shade) Class {
+	className := "divide-amber-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// RingAmber applies ring-amber-shade utility
+func RingAmber(shade Shade) Class {
+	className := "ring-amber-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// RingOffsetAmber applies ring-offset-amber-shade utility
+func RingOffsetAmber(shade Shade) Class {
+	className := "ring-offset-amber-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// OutlineAmber applies outline-amber-shade utility
+func OutlineAmber(shade Shade) Class {
+	className := "outline-amber-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// FromAmber applies from-amber-shade gradient stop utility
+func FromAmber(shade Shade) Class {
+	className := "from-amber-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ViaAmber applies via-amber-shade gradient stop utility
+func ViaAmber(shade Shade) Class {
+	className := "via-amber-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ToAmber applies to-amber-shade gradient stop utility
+func ToAmber(shade Shade) Class {
+	className := "to-amber-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BgBlack applies bg-black utility
+func BgBlack() Class {
+	trackClass("bg-black")
+	return "bg-black"
+}
+
+// TextBlack applies text-black utility
+func TextBlack() Class {
+	trackClass("text-black")
+	return "text-black"
+}
+
+// BorderBlack applies border-black utility
+func BorderBlack() Class {
+	trackClass("border-black")
+	return "border-black"
+}
+
+// BorderTBlack applies border-t-black utility
+func BorderTBlack() Class {
+	trackClass("border-t-black")
+	return "border-t-black"
+}
+
+// BorderRBlack applies border-r-black utility
+func BorderRBlack() Class {
+	trackClass("border-r-black")
+	return "border-r-black"
+}
+
+// BorderBBlack applies border-b-black utility
+func BorderBBlack() Class {
+	trackClass("border-b-black")
+	return "border-b-black"
+}
+
+// BorderLBlack applies border-l-black utility
+func BorderLBlack() Class {
+	trackClass("border-l-black")
+	return "border-l-black"
+}
+
+// DivideBlack applies divide-black utility
+func DivideBlack() Class {
+	trackClass("divide-black")
+	return "divide-black"
+}
+
+// RingBlack applies ring-black utility
+func RingBlack() Class {
+	trackClass("ring-black")
+	return "ring-black"
+}
+
+// RingOffsetBlack applies ring-offset-black utility
+func RingOffsetBlack() Class {
+	trackClass("ring-offset-black")
+	return "ring-offset-black"
+}
+
+// OutlineBlack applies outline-black utility
+func OutlineBlack() Class {
+	trackClass("outline-black")
+	return "outline-black"
+}
+
+// FromBlack applies from-black gradient stop utility
+func FromBlack() Class {
+	trackClass("from-black")
+	return "from-black"
+}
+
+// ViaBlack applies via-black gradient stop utility
+func ViaBlack() Class {
+	trackClass("via-black")
+	return "via-black"
+}
+
+// ToBlack applies to-black gradient stop utility
+func ToBlack() Class {
+	trackClass("to-black")
+	return "to-black"
+}
+
+// BgBlue applies bg-blue-shade utility
+func BgBlue(shade Shade) Class {
+	className := "bg-blue-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// TextBlue applies text-blue-shade utility
+func TextBlue(shade Shade) Class {
+	className := "text-blue-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderBlue applies border-blue-shade utility
+func BorderBlue(shade Shade) Class {
+	className := "border-blue-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderTBlue applies border-t-blue-shade utility
+func BorderTBlue(shade Shade) Class {
+	className := "border-t-blue-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderRBlue applies border-r-blue-shade utility
+func BorderRBlue(shade Shade) Class {
+	className := "border-r-blue-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderBBlue applies border-b-blue-shade utility
+func BorderBBlue(shade Shade) Class {
+	className := "border-b-blue-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderLBlue applies border-l-blue-shade utility
+func BorderLBlue(shade Shade) Class {
+	className := "border-l-blue-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// DivideBlue applies divide-blue-shade utility
+func DivideBlue(shade Shade) Class {
+	className := "divide-blue-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// RingBlue applies ring-blue-shade utility
+func RingBlue(shade Shade) Class {
+	className := "ring-blue-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// RingOffsetBlue applies ring-offset-blue-shade utility
+func RingOffsetBlue(shade Shade) Class {
+	className := "ring-offset-blue-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// OutlineBlue applies outline-blue-shade utility
+func OutlineBlue(shade Shade) Class {
+	className := "outline-blue-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// FromBlue applies from-blue-shade gradient stop utility
+func FromBlue(shade Shade) Class {
+	className := "from-blue-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ViaBlue applies via-blue-shade gradient stop utility
+func ViaBlue(shade Shade) Class {
+	className := "via-blue-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ToBlue applies to-blue-shade gradient stop utility
+func ToBlue(shade Shade) Class {
+	className := "to-blue-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BgCyan applies bg-cyan-shade utility
+func BgCyan(shade Shade) Class {
+	className := "bg-cyan-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// TextCyan applies text-cyan-shade utility
+func TextCyan(shade Shade) Class {
+	className := "text-cyan-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderCyan applies border-cyan-shade utility
+func BorderCyan(shade Shade) Class {
+	className := "border-cyan-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderTCyan applies border-t-cyan-shade utility
+func BorderTCyan(shade Shade) Class {
+	className := "border-t-cyan-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderRCyan applies border-r-cyan-shade utility
+func BorderRCyan(shade Shade) Class {
+	className := "border-r-cyan-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderBCyan applies border-b-cyan-shade utility
+func BorderBCyan(shade Shade) Class {
+	className := "border-b-cyan-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderLCyan applies border-l-cyan-shade utility
+func BorderLCyan(shade Shade) Class {
+	className := "border-l-cyan-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// DivideCyan applies divide-cyan-shade utility
+func DivideCyan(shade Shade) Class {
+	className := "divide-cyan-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// RingCyan applies ring-cyan-shade utility
+func RingCyan(shade Shade) Class {
+	className := "ring-cyan-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// RingOffsetCyan applies ring-offset-cyan-shade utility
+func RingOffsetCyan(shade Shade) Class {
+	className := "ring-offset-cyan-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// OutlineCyan applies outline-cyan-shade utility
+func OutlineCyan(shade Shade) Class {
+	className := "outline-cyan-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// FromCyan applies from-cyan-shade gradient stop utility
+func FromCyan(shade Shade) Class {
+	className := "from-cyan-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ViaCyan applies via-cyan-shade gradient stop utility
+func ViaCyan(shade Shade) Class {
+	className := "via-cyan-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ToCyan applies to-cyan-shade gradient stop utility
+func ToCyan(shade Shade) Class {
+	className := "to-cyan-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BgEmerald applies bg-emerald-shade utility
+func BgEmerald(shade Shade) Class {
+	className := "bg-emerald-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// TextEmerald applies text-emerald-shade utility
+func TextEmerald(shade Shade) Class {
+	className := "text-emerald-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderEmerald applies border-emerald-shade utility
+func BorderEmerald(shade Shade) Class {
+	className := "border-emerald-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderTEmerald applies border-t-emerald-shade utility
+func BorderTEmerald(shade Shade) Class {
+	className := "border-t-emerald-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderREmerald applies border-r-emerald-shade utility
+func BorderREmerald(shade Shade) Class {
+	className := "border-r-emerald-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderBEmerald applies border-b-emerald-shade utility
+func BorderBEmerald(shade Shade) Class {
+	className := "border-b-emerald-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderLEmerald applies border-l-emerald-shade utility
+func BorderLEmerald(shade Shade) Class {
+	className := "border-l-emerald-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// DivideEmerald applies divide-emerald-shade utility
+func DivideEmerald(shade Shade) Class {
+	className := "divide-emerald-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// RingEmerald applies ring-emerald-shade utility
+func RingEmerald(shade Shade) Class {
+	className := "ring-emerald-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// RingOffsetEmerald applies ring-offset-emerald-shade utility
+func RingOffsetEmerald(shade Shade) Class {
+	className := "ring-offset-emerald-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// OutlineEmerald applies outline-emerald-shade utility
+func OutlineEmerald(shade Shade) Class {
+	className := "outline-emerald-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// FromEmerald applies from-emerald-shade gradient stop utility
+func FromEmerald(shade Shade) Class {
+	className := "from-emerald-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ViaEmerald applies via-emerald-shade gradient stop utility
+func ViaEmerald(shade Shade) Class {
+	className := "via-emerald-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ToEmerald applies to-emerald-shade gradient stop utility
+func ToEmerald(shade Shade) Class {
+	className := "to-emerald-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BgFuchsia applies bg-fuchsia-shade utility
+func BgFuchsia(shade Shade) Class {
+	className := "bg-fuchsia-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// TextFuchsia applies text-fuchsia-shade utility
+func TextFuchsia(shade Shade) Class {
+	className := "text-fuchsia-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderFuchsia applies border-fuchsia-shade utility
+func BorderFuchsia(shade Shade) Class {
+	className := "border-fuchsia-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderTFuchsia applies border-t-fuchsia-shade utility
+func BorderTFuchsia(shade Shade) Class {
+	className := "border-t-fuchsia-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderRFuchsia applies border-r-fuchsia-shade utility
+func BorderRFuchsia(shade Shade) Class {
+	className := "border-r-fuchsia-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderBFuchsia applies border-b-fuchsia-shade utility
+func BorderBFuchsia(shade Shade) Class {
+	className := "border-b-fuchsia-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderLFuchsia applies border-l-fuchsia-shade utility
+func BorderLFuchsia(shade Shade) Class {
+	className := "border-l-fuchsia-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// DivideFuchsia applies divide-fuchsia-shade utility
+func DivideFuchsia(shade Shade) Class {
+	className := "divide-fuchsia-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// RingFuchsia applies ring-fuchsia-shade utility
+func RingFuchsia(shade Shade) Class {
+	className := "ring-fuchsia-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// RingOffsetFuchsia applies ring-offset-fuchsia-shade utility
+func RingOffsetFuchsia(shade Shade) Class {
+	className := "ring-offset-fuchsia-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// OutlineFuchsia applies outline-fuchsia-shade utility
+func OutlineFuchsia(shade Shade) Class {
+	className := "outline-fuchsia-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// FromFuchsia applies from-fuchsia-shade gradient stop utility
+func FromFuchsia(shade Shade) Class {
+	className := "from-fuchsia-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ViaFuchsia applies via-fuchsia-shade gradient stop utility
+func ViaFuchsia(shade Shade) Class {
+	className := "via-fuchsia-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ToFuchsia applies to-fuchsia-shade gradient stop utility
+func ToFuchsia(shade Shade) Class {
+	className := "to-fuchsia-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BgGray applies bg-gray-shade utility
+func BgGray(shade Shade) Class {
+	className := "bg-gray-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// TextGray applies text-gray-shade utility
+func TextGray(shade Shade) Class {
+	className := "text-gray-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderGray applies border-gray-shade utility
+func BorderGray(shade Shade) Class {
+	className := "border-gray-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderTGray applies border-t-gray-shade utility
+func BorderTGray(shade Shade) Class {
+	className := "border-t-gray-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderRGray applies border-r-gray-shade utility
+func BorderRGray(shade Shade) Class {
+	className := "border-r-gray-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderBGray applies border-b-gray-shade utility
+func BorderBGray(shade Shade) Class {
+	className := "border-b-gray-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderLGray applies border-l-gray-shade utility
+func BorderLGray(shade Shade) Class {
+	className := "border-l-gray-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// DivideGray applies divide-gray-shade utility
+func DivideGray(shade Shade) Class {
+	className := "divide-gray-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// RingGray applies ring-gray-shade utility
+func RingGray(shade Shade) Class {
+	className := "ring-gray-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// RingOffsetGray applies ring-offset-gray-shade utility
+func RingOffsetGray(shade Shade) Class {
+	className := "ring-offset-gray-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// OutlineGray applies outline-gray-shade utility
+func OutlineGray(shade Shade) Class {
+	className := "outline-gray-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// FromGray applies from-gray-shade gradient stop utility
+func FromGray(shade Shade) Class {
+	className := "from-gray-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ViaGray applies via-gray-shade gradient stop utility
+func ViaGray(shade Shade) Class {
+	className := "via-gray-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ToGray applies to-gray-shade gradient stop utility
+func ToGray(shade Shade) Class {
+	className := "to-gray-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BgGreen applies bg-green-shade utility
+func BgGreen(shade Shade) Class {
+	className := "bg-green-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// TextGreen applies text-green-shade utility
+func TextGreen(shade Shade) Class {
+	className := "text-green-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderGreen applies border-green-shade utility
+func BorderGreen(shade Shade) Class {
+	className := "border-green-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderTGreen applies border-t-green-shade utility
+func BorderTGreen(shade Shade) Class {
+	className := "border-t-green-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderRGreen applies border-r-green-shade utility
+func BorderRGreen(shade Shade) Class {
+	className := "border-r-green-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderBGreen applies border-b-green-shade utility
+func BorderBGreen(shade Shade) Class {
+	className := "border-b-green-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderLGreen applies border-l-green-shade utility
+func BorderLGreen(shade Shade) Class {
+	className := "border-l-green-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// DivideGreen applies divide-green-shade utility
+func DivideGreen(shade Shade) Class {
+	className := "divide-green-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// RingGreen applies ring-green-shade utility
+func RingGreen(shade Shade) Class {
+	className := "ring-green-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// RingOffsetGreen applies ring-offset-green-shade utility
+func RingOffsetGreen(shade Shade) Class {
+	className := "ring-offset-green-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// OutlineGreen applies outline-green-shade utility
+func OutlineGreen(shade Shade) Class {
+	className := "outline-green-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// FromGreen applies from-green-shade gradient stop utility
+func FromGreen(shade Shade) Class {
+	className := "from-green-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ViaGreen applies via-green-shade gradient stop utility
+func ViaGreen(shade Shade) Class {
+	className := "via-green-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ToGreen applies to-green-shade gradient stop utility
+func ToGreen(shade Shade) Class {
+	className := "to-green-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BgIndigo applies bg-indigo-shade utility
+func BgIndigo(shade Shade) Class {
+	className := "bg-indigo-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// TextIndigo applies text-indigo-shade utility
+func TextIndigo(shade Shade) Class {
+	className := "text-indigo-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderIndigo applies border-indigo-shade utility
+func BorderIndigo(shade Shade) Class {
+	className := "border-indigo-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderTIndigo applies border-t-indigo-shade utility
+func BorderTIndigo(shade Shade) Class {
+	className := "border-t-indigo-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderRIndigo applies border-r-indigo-shade utility
+func BorderRIndigo(shade Shade) Class {
+	className := "border-r-indigo-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderBIndigo applies border-b-indigo-shade utility
+func BorderBIndigo(shade Shade) Class {
+	className := "border-b-indigo-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderLIndigo applies border-l-indigo-shade utility
+func BorderLIndigo(shade Shade) Class {
+	className := "border-l-indigo-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// DivideIndigo applies divide-indigo-shade utility
+func DivideIndigo(shade Shade) Class {
+	className := "divide-indigo-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// RingIndigo applies ring-indigo-shade utility
+func RingIndigo(shade Shade) Class {
+	className := "ring-indigo-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// RingOffsetIndigo applies ring-offset-indigo-shade utility
+func RingOffsetIndigo(shade Shade) Class {
+	className := "ring-offset-indigo-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// OutlineIndigo applies outline-indigo-shade utility
+func OutlineIndigo(shade Shade) Class {
+	className := "outline-indigo-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// FromIndigo applies from-indigo-shade gradient stop utility
+func FromIndigo(shade Shade) Class {
+	className := "from-indigo-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ViaIndigo applies via-indigo-shade gradient stop utility
+func ViaIndigo(shade Shade) Class {
+	className := "via-indigo-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ToIndigo applies to-indigo-shade gradient stop utility
+func ToIndigo(shade Shade) Class {
+	className := "to-indigo-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BgLime applies bg-lime-shade utility
+func BgLime(shade Shade) Class {
+	className := "bg-lime-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// TextLime applies text-lime-shade utility
+func TextLime(shade Shade) Class {
+	className := "text-lime-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderLime applies border-lime-shade utility
+func BorderLime(shade Shade) Class {
+	className := "border-lime-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderTLime applies border-t-lime-shade utility
+func BorderTLime(shade Shade) Class {
+	className := "border-t-lime-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderRLime applies border-r-lime-shade utility
+func BorderRLime(shade Shade) Class {
+	className := "border-r-lime-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderBLime applies border-b-lime-shade utility
+func BorderBLime(shade Shade) Class {
+	className := "border-b-lime-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderLLime applies border-l-lime-shade utility
+func BorderLLime(shade Shade) Class {
+	className := "border-l-lime-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// DivideLime applies divide-lime-shade utility
+func DivideLime(shade Shade) Class {
+	className := "divide-lime-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// RingLime applies ring-lime-shade utility
+func RingLime(shade Shade) Class {
+	className := "ring-lime-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// RingOffsetLime applies ring-offset-lime-shade utility
+func RingOffsetLime(shade Shade) Class {
+	className := "ring-offset-lime-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// OutlineLime applies outline-lime-shade utility
+func OutlineLime(shade Shade) Class {
+	className := "outline-lime-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// FromLime applies from-lime-shade gradient stop utility
+func FromLime(shade Shade) Class {
+	className := "from-lime-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ViaLime applies via-lime-shade gradient stop utility
+func ViaLime(shade Shade) Class {
+	className := "via-lime-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ToLime applies to-lime-shade gradient stop utility
+func ToLime(shade Shade) Class {
+	className := "to-lime-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BgNeutral applies bg-neutral-shade utility
+func BgNeutral(shade Shade) Class {
+	className := "bg-neutral-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// TextNeutral applies text-neutral-shade utility
+func TextNeutral(shade Shade) Class {
+	className := "text-neutral-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderNeutral applies border-neutral-shade utility
+func BorderNeutral(shade Shade) Class {
+	className := "border-neutral-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderTNeutral applies border-t-neutral-shade utility
+func BorderTNeutral(shade Shade) Class {
+	className := "border-t-neutral-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderRNeutral applies border-r-neutral-shade utility
+func BorderRNeutral(shade Shade) Class {
+	className := "border-r-neutral-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderBNeutral applies border-b-neutral-shade utility
+func BorderBNeutral(shade Shade) Class {
+	className := "border-b-neutral-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderLNeutral applies border-l-neutral-shade utility
+func BorderLNeutral(shade Shade) Class {
+	className := "border-l-neutral-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// DivideNeutral applies divide-neutral-shade utility
+func DivideNeutral(shade Shade) Class {
+	className := "divide-neutral-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// RingNeutral applies ring-neutral-shade utility
+func RingNeutral(shade Shade) Class {
+	className := "ring-neutral-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// RingOffsetNeutral applies ring-offset-neutral-shade utility
+func RingOffsetNeutral(shade Shade) Class {
+	className := "ring-offset-neutral-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// OutlineNeutral applies outline-neutral-shade utility
+func OutlineNeutral(shade Shade) Class {
+	className := "outline-neutral-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// FromNeutral applies from-neutral-shade gradient stop utility
+func FromNeutral(shade Shade) Class {
+	className := "from-neutral-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ViaNeutral applies via-neutral-shade gradient stop utility
+func ViaNeutral(shade Shade) Class {
+	className := "via-neutral-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ToNeutral applies to-neutral-shade gradient stop utility
+func ToNeutral(shade Shade) Class {
+	className := "to-neutral-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BgOrange applies bg-orange-shade utility
+func BgOrange(shade Shade) Class {
+	className := "bg-orange-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// TextOrange applies text-orange-shade utility
+func TextOrange(shade Shade) Class {
+	className := "text-orange-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderOrange applies border-orange-shade utility
+func BorderOrange(shade Shade) Class {
+	className := "border-orange-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderTOrange applies border-t-orange-shade utility
+func BorderTOrange(shade Shade) Class {
+	className := "border-t-orange-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderROrange applies border-r-orange-shade utility
+func BorderROrange(shade Shade) Class {
+	className := "border-r-orange-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderBOrange applies border-b-orange-shade utility
+func BorderBOrange(shade Shade) Class {
+	className := "border-b-orange-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderLOrange applies border-l-orange-shade utility
+func BorderLOrange(shade Shade) Class {
+	className := "border-l-orange-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// DivideOrange applies divide-orange-shade utility
+func DivideOrange(shade Shade) Class {
+	className := "divide-orange-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// RingOrange applies ring-orange-shade utility
+func RingOrange(shade Shade) Class {
+	className := "ring-orange-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// RingOffsetOrange applies ring-offset-orange-shade utility
+func RingOffsetOrange(shade Shade) Class {
+	className := "ring-offset-orange-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// OutlineOrange applies outline-orange-shade utility
+func OutlineOrange(shade Shade) Class {
+	className := "outline-orange-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// FromOrange applies from-orange-shade gradient stop utility
+func FromOrange(shade Shade) Class {
+	className := "from-orange-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ViaOrange applies via-orange-shade gradient stop utility
+func ViaOrange(shade Shade) Class {
+	className := "via-orange-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ToOrange applies to-orange-shade gradient stop utility
+func ToOrange(shade Shade) Class {
+	className := "to-orange-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BgPink applies bg-pink-shade utility
+func BgPink(shade Shade) Class {
+	className := "bg-pink-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// TextPink applies text-pink-shade utility
+func TextPink(shade Shade) Class {
+	className := "text-pink-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderPink applies border-pink-shade utility
+func BorderPink(shade Shade) Class {
+	className := "border-pink-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderTPink applies border-t-pink-shade utility
+func BorderTPink(shade Shade) Class {
+	className := "border-t-pink-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderRPink applies border-r-pink-shade utility
+func BorderRPink(shade Shade) Class {
+	className := "border-r-pink-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderBPink applies border-b-pink-shade utility
+func BorderBPink(shade Shade) Class {
+	className := "border-b-pink-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderLPink applies border-l-pink-shade utility
+func BorderLPink(shade Shade) Class {
+	className := "border-l-pink-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// DividePink applies divide-pink-shade utility
+func DividePink(shade Shade) Class {
+	className := "divide-pink-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// RingPink applies ring-pink-shade utility
+func RingPink(shade Shade) Class {
+	className := "ring-pink-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// RingOffsetPink applies ring-offset-pink-shade utility
+func RingOffsetPink(shade Shade) Class {
+	className := "ring-offset-pink-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// OutlinePink applies outline-pink-shade utility
+func OutlinePink(shade Shade) Class {
+	className := "outline-pink-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// FromPink applies from-pink-shade gradient stop utility
+func FromPink(shade Shade) Class {
+	className := "from-pink-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ViaPink applies via-pink-shade gradient stop utility
+func ViaPink(shade Shade) Class {
+	className := "via-pink-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
 
-type Class string
+// ToPink applies to-pink-shade gradient stop utility
+func ToPink(shade Shade) Class {
+	className := "to-pink-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
 
-func (c Class) String() string {
-	return string(c)
+// BgPurple applies bg-purple-shade utility
+func BgPurple(shade Shade) Class {
+	className := "bg-purple-" + shade.String()
+	trackClass(className)
+	return Class(className)
 }
 
-// Global class tracker
-var (
-	usedClasses = make(map[string]bool)
-	classMutex  sync.RWMutex
-)
+// TextPurple applies text-purple-shade utility
+func TextPurple(shade Shade) Class {
+	className := "text-purple-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
 
-// trackClass registers a class as being used
-func trackClass(className string) {
-	classMutex.Lock()
-	defer classMutex.Unlock()
-	usedClasses[className] = true
+// BorderPurple applies border-purple-shade utility
+func BorderPurple(shade Shade) Class {
+	className := "border-purple-" + shade.String()
+	trackClass(className)
+	return Class(className)
 }
 
-// GetUsedClasses returns a slice of all tracked classes
-func GetUsedClasses() []string {
-	classMutex.RLock()
-	defer classMutex.RUnlock()
-	classes := make([]string, 0, len(usedClasses))
-	for class := range usedClasses {
-		classes = append(classes, class)
-	}
-	return classes
+// BorderTPurple applies border-t-purple-shade utility
+func BorderTPurple(shade Shade) Class {
+	className := "border-t-purple-" + shade.String()
+	trackClass(className)
+	return Class(className)
 }
 
-// ResetTracking clears all tracked classes
-func ResetTracking() {
-	classMutex.Lock()
-	defer classMutex.Unlock()
-	usedClasses = make(map[string]bool)
+// BorderRPurple applies border-r-purple-shade utility
+func BorderRPurple(shade Shade) Class {
+	className := "border-r-purple-" + shade.String()
+	trackClass(className)
+	return Class(className)
 }
 
-// Stylesheet wraps the internal stylesheet type
-type Stylesheet struct {
-	internal interface{ GenerateCSS() string }
+// BorderBPurple applies border-b-purple-shade utility
+func BorderBPurple(shade Shade) Class {
+	className := "border-b-purple-" + shade.String()
+	trackClass(className)
+	return Class(className)
 }
 
-// Generate returns the CSS string
-func (s *Stylesheet) Generate() string {
-	if s.internal == nil {
-		return ""
-	}
-	if gen, ok := s.internal.(interface{ GenerateCSS() string }); ok {
-		return gen.GenerateCSS()
-	}
-	return ""
+// BorderLPurple applies border-l-purple-shade utility
+func BorderLPurple(shade Shade) Class {
+	className := "border-l-purple-" + shade.String()
+	trackClass(className)
+	return Class(className)
 }
 
-// GenerateUtilities creates CSS rules using the config-driven approach
-func GenerateUtilities() *Stylesheet {
-	return &Stylesheet{internal: internal.GenerateUtilities()}
+// DividePurple applies divide-purple-shade utility
+func DividePurple(shade Shade) Class {
+	className := "divide-purple-" + shade.String()
+	trackClass(className)
+	return Class(className)
 }
 
-// GenerateMinimalCSS generates CSS only for tracked classes
-func GenerateMinimalCSS() *Stylesheet {
-	return &Stylesheet{internal: internal.GenerateMinimalCSS(GetUsedClasses())}
+// RingPurple applies ring-purple-shade utility
+func RingPurple(shade Shade) Class {
+	className := "ring-purple-" + shade.String()
+	trackClass(className)
+	return Class(className)
 }
 
-// padding applies padding utility
-func P(size int) Class {
-	className := fmt.Sprintf("p-%d", size)
+// RingOffsetPurple applies ring-offset-purple-shade utility
+func RingOffsetPurple(shade Shade) Class {
+	className := "ring-offset-purple-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// padding-x applies padding-x utility
-func Px(size int) Class {
-	className := fmt.Sprintf("px-%d", size)
+// OutlinePurple applies outline-purple-shade utility
+func OutlinePurple(shade Shade) Class {
+	className := "outline-purple-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// padding-y applies padding-y utility
-func Py(size int) Class {
-	className := fmt.Sprintf("py-%d", size)
+// FromPurple applies from-purple-shade gradient stop utility
+func FromPurple(shade Shade) Class {
+	className := "from-purple-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// padding-top applies padding-top utility
-func Pt(size int) Class {
-	className := fmt.Sprintf("pt-%d", size)
+// ViaPurple applies via-purple-shade gradient stop utility
+func ViaPurple(shade Shade) Class {
+	className := "via-purple-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// padding-right applies padding-right utility
-func Pr(size int) Class {
-	className := fmt.Sprintf("pr-%d", size)
+// ToPurple applies to-purple-shade gradient stop utility
+func ToPurple(shade Shade) Class {
+	className := "to-purple-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// padding-bottom applies padding-bottom utility
-func Pb(size int) Class {
-	className := fmt.Sprintf("pb-%d", size)
+// BgRed applies bg-red-shade utility
+func BgRed(shade Shade) Class {
+	className := "bg-red-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// padding-left applies padding-left utility
-func Pl(size int) Class {
-	className := fmt.Sprintf("pl-%d", size)
+// TextRed applies text-red-shade utility
+func TextRed(shade Shade) Class {
+	className := "text-red-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// margin applies margin utility
-func M(size int) Class {
-	className := fmt.Sprintf("m-%d", size)
+// BorderRed applies border-red-shade utility
+func BorderRed(shade Shade) Class {
+	className := "border-red-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// margin-x applies margin-x utility
-func Mx(size int) Class {
-	className := fmt.Sprintf("mx-%d", size)
+// BorderTRed applies border-t-red-shade utility
+func BorderTRed(shade Shade) Class {
+	className := "border-t-red-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// margin-y applies margin-y utility
-func My(size int) Class {
-	className := fmt.Sprintf("my-%d", size)
+// BorderRRed applies border-r-red-shade utility
+func BorderRRed(shade Shade) Class {
+	className := "border-r-red-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// margin-top applies margin-top utility
-func Mt(size int) Class {
-	className := fmt.Sprintf("mt-%d", size)
+// BorderBRed applies border-b-red-shade utility
+func BorderBRed(shade Shade) Class {
+	className := "border-b-red-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// margin-right applies margin-right utility
-func Mr(size int) Class {
-	className := fmt.Sprintf("mr-%d", size)
+// BorderLRed applies border-l-red-shade utility
+func BorderLRed(shade Shade) Class {
+	className := "border-l-red-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// margin-bottom applies margin-bottom utility
-func Mb(size int) Class {
-	className := fmt.Sprintf("mb-%d", size)
+// DivideRed applies divide-red-shade utility
+func DivideRed(shade Shade) Class {
+	className := "divide-red-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// margin-left applies margin-left utility
-func Ml(size int) Class {
-	className := fmt.Sprintf("ml-%d", size)
+// RingRed applies ring-red-shade utility
+func RingRed(shade Shade) Class {
+	className := "ring-red-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// space-x applies space-x utility
-func SpaceX(size int) Class {
-	className := fmt.Sprintf("space-x-%d", size)
+// RingOffsetRed applies ring-offset-red-shade utility
+func RingOffsetRed(shade Shade) Class {
+	className := "ring-offset-red-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// space-y applies space-y utility
-func SpaceY(size int) Class {
-	className := fmt.Sprintf("space-y-%d", size)
+// OutlineRed applies outline-red-shade utility
+func OutlineRed(shade Shade) Class {
+	className := "outline-red-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgPink applies bg-pink-shade utility
-func BgPink(shade int) Class {
-	className := fmt.Sprintf("bg-pink-%d", shade)
+// FromRed applies from-red-shade gradient stop utility
+func FromRed(shade Shade) Class {
+	className := "from-red-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextPink applies text-pink-shade utility
-func TextPink(shade int) Class {
-	className := fmt.Sprintf("text-pink-%d", shade)
+// ViaRed applies via-red-shade gradient stop utility
+func ViaRed(shade Shade) Class {
+	className := "via-red-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ToRed applies to-red-shade gradient stop utility
+func ToRed(shade Shade) Class {
+	className := "to-red-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // BgRose applies bg-rose-shade utility
-func BgRose(shade int) Class {
-	className := fmt.Sprintf("bg-rose-%d", shade)
+func BgRose(shade Shade) Class {
+	className := "bg-rose-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // TextRose applies text-rose-shade utility
-func TextRose(shade int) Class {
-	className := fmt.Sprintf("text-rose-%d", shade)
+func TextRose(shade Shade) Class {
+	className := "text-rose-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgNeutral applies bg-neutral-shade utility
-func BgNeutral(shade int) Class {
-	className := fmt.Sprintf("bg-neutral-%d", shade)
+// BorderRose applies border-rose-shade utility
+func BorderRose(shade Shade) Class {
+	className := "border-rose-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextNeutral applies text-neutral-shade utility
-func TextNeutral(shade int) Class {
-	className := fmt.Sprintf("text-neutral-%d", shade)
+// BorderTRose applies border-t-rose-shade utility
+func BorderTRose(shade Shade) Class {
+	className := "border-t-rose-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgOrange applies bg-orange-shade utility
-func BgOrange(shade int) Class {
-	className := fmt.Sprintf("bg-orange-%d", shade)
+// BorderRRose applies border-r-rose-shade utility
+func BorderRRose(shade Shade) Class {
+	className := "border-r-rose-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextOrange applies text-orange-shade utility
-func TextOrange(shade int) Class {
-	className := fmt.Sprintf("text-orange-%d", shade)
+// BorderBRose applies border-b-rose-shade utility
+func BorderBRose(shade Shade) Class {
+	className := "border-b-rose-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgAmber applies bg-amber-shade utility
-func BgAmber(shade int) Class {
-	className := fmt.Sprintf("bg-amber-%d", shade)
+// BorderLRose applies border-l-rose-shade utility
+func BorderLRose(shade Shade) Class {
+	className := "border-l-rose-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextAmber applies text-amber-shade utility
-func TextAmber(shade int) Class {
-	className := fmt.Sprintf("text-amber-%d", shade)
+// DivideRose applies divide-rose-shade utility
+func DivideRose(shade Shade) Class {
+	className := "divide-rose-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgEmerald applies bg-emerald-shade utility
-func BgEmerald(shade int) Class {
-	className := fmt.Sprintf("bg-emerald-%d", shade)
+// RingRose applies ring-rose-shade utility
+func RingRose(shade Shade) Class {
+	className := "ring-rose-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextEmerald applies text-emerald-shade utility
-func TextEmerald(shade int) Class {
-	className := fmt.Sprintf("text-emerald-%d", shade)
+// RingOffsetRose applies ring-offset-rose-shade utility
+func RingOffsetRose(shade Shade) Class {
+	className := "ring-offset-rose-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// OutlineRose applies outline-rose-shade utility
+func OutlineRose(shade Shade) Class {
+	className := "outline-rose-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// FromRose applies from-rose-shade gradient stop utility
+func FromRose(shade Shade) Class {
+	className := "from-rose-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ViaRose applies via-rose-shade gradient stop utility
+func ViaRose(shade Shade) Class {
+	className := "via-rose-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ToRose applies to-rose-shade gradient stop utility
+func ToRose(shade Shade) Class {
+	className := "to-rose-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // BgSky applies bg-sky-shade utility
-func BgSky(shade int) Class {
-	className := fmt.Sprintf("bg-sky-%d", shade)
+func BgSky(shade Shade) Class {
+	className := "bg-sky-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // TextSky applies text-sky-shade utility
-func TextSky(shade int) Class {
-	className := fmt.Sprintf("text-sky-%d", shade)
+func TextSky(shade Shade) Class {
+	className := "text-sky-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgFuchsia applies bg-fuchsia-shade utility
-func BgFuchsia(shade int) Class {
-	className := fmt.Sprintf("bg-fuchsia-%d", shade)
+// BorderSky applies border-sky-shade utility
+func BorderSky(shade Shade) Class {
+	className := "border-sky-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextFuchsia applies text-fuchsia-shade utility
-func TextFuchsia(shade int) Class {
-	className := fmt.Sprintf("text-fuchsia-%d", shade)
+// BorderTSky applies border-t-sky-shade utility
+func BorderTSky(shade Shade) Class {
+	className := "border-t-sky-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgRed applies bg-red-shade utility
-func BgRed(shade int) Class {
-	className := fmt.Sprintf("bg-red-%d", shade)
+// BorderRSky applies border-r-sky-shade utility
+func BorderRSky(shade Shade) Class {
+	className := "border-r-sky-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextRed applies text-red-shade utility
-func TextRed(shade int) Class {
-	className := fmt.Sprintf("text-red-%d", shade)
+// BorderBSky applies border-b-sky-shade utility
+func BorderBSky(shade Shade) Class {
+	className := "border-b-sky-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgCyan applies bg-cyan-shade utility
-func BgCyan(shade int) Class {
-	className := fmt.Sprintf("bg-cyan-%d", shade)
+// BorderLSky applies border-l-sky-shade utility
+func BorderLSky(shade Shade) Class {
+	className := "border-l-sky-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextBlue applies text-blue-shade utility
-func TextBlue(shade int) Class {
-	className := fmt.Sprintf("text-blue-%d", shade)
+// DivideSky applies divide-sky-shade utility
+func DivideSky(shade Shade) Class {
+	className := "divide-sky-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgRose applies bg-rose-shade utility
-func BgRose(shade int) Class {
-	className := fmt.Sprintf("bg-rose-%d", shade)
+// RingSky applies ring-sky-shade utility
+func RingSky(shade Shade) Class {
+	className := "ring-sky-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextRose applies text-rose-shade utility
-func TextRose(shade int) Class {
-	className := fmt.Sprintf("text-rose-%d", shade)
+// RingOffsetSky applies ring-offset-sky-shade utility
+func RingOffsetSky(shade Shade) Class {
+	className := "ring-offset-sky-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgGray applies bg-gray-shade utility
-func BgGray(shade int) Class {
-	className := fmt.Sprintf("bg-gray-%d", shade)
+// OutlineSky applies outline-sky-shade utility
+func OutlineSky(shade Shade) Class {
+	className := "outline-sky-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextGray applies text-gray-shade utility
-func TextGray(shade int) Class {
-	className := fmt.Sprintf("text-gray-%d", shade)
+// FromSky applies from-sky-shade gradient stop utility
+func FromSky(shade Shade) Class {
+	className := "from-sky-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgZinc applies bg-zinc-shade utility
-func BgZinc(shade int) Class {
-	className := fmt.Sprintf("bg-zinc-%d", shade)
+// ViaSky applies via-sky-shade gradient stop utility
+func ViaSky(shade Shade) Class {
+	className := "via-sky-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextZinc applies text-zinc-shade utility
-func TextZinc(shade int) Class {
-	className := fmt.Sprintf("text-zinc-%d", shade)
+// ToSky applies to-sky-shade gradient stop utility
+func ToSky(shade Shade) Class {
+	className := "to-sky-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgSky applies bg-sky-shade utility
-func BgSky(shade int) Class {
-	className := fmt.Sprintf("bg-sky-%d", shade)
+// BgSlate applies bg-slate-shade utility
+func BgSlate(shade Shade) Class {
+	className := "bg-slate-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextSky applies text-sky-shade utility
-func TextSky(shade int) Class {
-	className := fmt.Sprintf("text-sky-%d", shade)
+// TextSlate applies text-slate-shade utility
+func TextSlate(shade Shade) Class {
+	className := "text-slate-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgIndigo applies bg-indigo-shade utility
-func BgIndigo(shade int) Class {
-	className := fmt.Sprintf("bg-indigo-%d", shade)
+// BorderSlate applies border-slate-shade utility
+func BorderSlate(shade Shade) Class {
+	className := "border-slate-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextIndigo applies text-indigo-shade utility
-func TextIndigo(shade int) Class {
-	className := fmt.Sprintf("text-indigo-%d", shade)
+// BorderTSlate applies border-t-slate-shade utility
+func BorderTSlate(shade Shade) Class {
+	className := "border-t-slate-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgViolet applies bg-violet-shade utility
-func BgViolet(shade int) Class {
-	className := fmt.Sprintf("bg-violet-%d", shade)
+// BorderRSlate applies border-r-slate-shade utility
+func BorderRSlate(shade Shade) Class {
+	className := "border-r-slate-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextViolet applies text-violet-shade utility
-func TextViolet(shade int) Class {
-	className := fmt.Sprintf("text-violet-%d", shade)
+// BorderBSlate applies border-b-slate-shade utility
+func BorderBSlate(shade Shade) Class {
+	className := "border-b-slate-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgFuchsia applies bg-fuchsia-shade utility
-func BgFuchsia(shade int) Class {
-	className := fmt.Sprintf("bg-fuchsia-%d", shade)
+// BorderLSlate applies border-l-slate-shade utility
+func BorderLSlate(shade Shade) Class {
+	className := "border-l-slate-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextFuchsia applies text-fuchsia-shade utility
-func TextFuchsia(shade int) Class {
-	className := fmt.Sprintf("text-fuchsia-%d", shade)
+// DivideSlate applies divide-slate-shade utility
+func DivideSlate(shade Shade) Class {
+	className := "divide-slate-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgBlue applies bg-blue-shade utility
-func BgBlue(shade int) Class {
-	className := fmt.Sprintf("bg-blue-%d", shade)
+// RingSlate applies ring-slate-shade utility
+func RingSlate(shade Shade) Class {
+	className := "ring-slate-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextBlue applies text-blue-shade utility
-func TextBlue(shade int) Class {
-	className := fmt.Sprintf("text-blue-%d", shade)
+// RingOffsetSlate applies ring-offset-slate-shade utility
+func RingOffsetSlate(shade Shade) Class {
+	className := "ring-offset-slate-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgZinc applies bg-zinc-shade utility
-func BgZinc(shade int) Class {
-	className := fmt.Sprintf("bg-zinc-%d", shade)
+// OutlineSlate applies outline-slate-shade utility
+func OutlineSlate(shade Shade) Class {
+	className := "outline-slate-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextZinc applies text-zinc-shade utility
-func TextZinc(shade int) Class {
-	className := fmt.Sprintf("text-zinc-%d", shade)
+// FromSlate applies from-slate-shade gradient stop utility
+func FromSlate(shade Shade) Class {
+	className := "from-slate-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ViaSlate applies via-slate-shade gradient stop utility
+func ViaSlate(shade Shade) Class {
+	className := "via-slate-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ToSlate applies to-slate-shade gradient stop utility
+func ToSlate(shade Shade) Class {
+	className := "to-slate-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // BgStone applies bg-stone-shade utility
-func BgStone(shade int) Class {
-	className := fmt.Sprintf("bg-stone-%d", shade)
+func BgStone(shade Shade) Class {
+	className := "bg-stone-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // TextStone applies text-stone-shade utility
-func TextStone(shade int) Class {
-	className := fmt.Sprintf("text-stone-%d", shade)
+func TextStone(shade Shade) Class {
+	className := "text-stone-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgBlack applies bg-black-shade utility
-func BgBlack(shade int) Class {
-	className := fmt.Sprintf("bg-black-%d", shade)
+// BorderStone applies border-stone-shade utility
+func BorderStone(shade Shade) Class {
+	className := "border-stone-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextBlack applies text-black-shade utility
-func TextBlack(shade int) Class {
-	className := fmt.Sprintf("text-black-%d", shade)
+// BorderTStone applies border-t-stone-shade utility
+func BorderTStone(shade Shade) Class {
+	className := "border-t-stone-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgRed applies bg-red-shade utility
-func BgRed(shade int) Class {
-	className := fmt.Sprintf("bg-red-%d", shade)
+// BorderRStone applies border-r-stone-shade utility
+func BorderRStone(shade Shade) Class {
+	className := "border-r-stone-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextRed applies text-red-shade utility
-func TextRed(shade int) Class {
-	className := fmt.Sprintf("text-red-%d", shade)
+// BorderBStone applies border-b-stone-shade utility
+func BorderBStone(shade Shade) Class {
+	className := "border-b-stone-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgYellow applies bg-yellow-shade utility
-func BgYellow(shade int) Class {
-	className := fmt.Sprintf("bg-yellow-%d", shade)
+// BorderLStone applies border-l-stone-shade utility
+func BorderLStone(shade Shade) Class {
+	className := "border-l-stone-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextYellow applies text-yellow-shade utility
-func TextYellow(shade int) Class {
-	className := fmt.Sprintf("text-yellow-%d", shade)
+// DivideStone applies divide-stone-shade utility
+func DivideStone(shade Shade) Class {
+	className := "divide-stone-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgCyan applies bg-cyan-shade utility
-func BgCyan(shade int) Class {
-	className := fmt.Sprintf("bg-cyan-%d", shade)
+// RingStone applies ring-stone-shade utility
+func RingStone(shade Shade) Class {
+	className := "ring-stone-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextCyan applies text-cyan-shade utility
-func TextCyan(shade int) Class {
-	className := fmt.Sprintf("text-cyan-%d", shade)
+// RingOffsetStone applies ring-offset-stone-shade utility
+func RingOffsetStone(shade Shade) Class {
+	className := "ring-offset-stone-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgSlate applies bg-slate-shade utility
-func BgSlate(shade int) Class {
-	className := fmt.Sprintf("bg-slate-%d", shade)
+// OutlineStone applies outline-stone-shade utility
+func OutlineStone(shade Shade) Class {
+	className := "outline-stone-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextSlate applies text-slate-shade utility
-func TextSlate(shade int) Class {
-	className := fmt.Sprintf("text-slate-%d", shade)
+// FromStone applies from-stone-shade gradient stop utility
+func FromStone(shade Shade) Class {
+	className := "from-stone-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgNeutral applies bg-neutral-shade utility
-func BgNeutral(shade int) Class {
-	className := fmt.Sprintf("bg-neutral-%d", shade)
+// ViaStone applies via-stone-shade gradient stop utility
+func ViaStone(shade Shade) Class {
+	className := "via-stone-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ToStone applies to-stone-shade gradient stop utility
+func ToStone(shade Shade) Class {
+	className := "to-stone-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BgTeal applies bg-teal-shade utility
+func BgTeal(shade Shade) Class {
+	className := "bg-teal-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextNeutral applies text-neutral-shade utility
-func TextNeutral(shade int) Class {
-	className := fmt.Sprintf("text-neutral-%d", shade)
-	trackClass(className)
-	return Class(className)
+// TextTeal applies text-teal-shade utility
+func TextTeal(shade Shade) Class {
+	className := "text-teal-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderTeal applies border-teal-shade utility
+func BorderTeal(shade Shade) Class {
+	className := "border-teal-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderTTeal applies border-t-teal-shade utility
+func BorderTTeal(shade Shade) Class {
+	className := "border-t-teal-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderRTeal applies border-r-teal-shade utility
+func BorderRTeal(shade Shade) Class {
+	className := "border-r-teal-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderBTeal applies border-b-teal-shade utility
+func BorderBTeal(shade Shade) Class {
+	className := "border-b-teal-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderLTeal applies border-l-teal-shade utility
+func BorderLTeal(shade Shade) Class {
+	className := "border-l-teal-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// DivideTeal applies divide-teal-shade utility
+func DivideTeal(shade Shade) Class {
+	className := "divide-teal-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// RingTeal applies ring-teal-shade utility
+func RingTeal(shade Shade) Class {
+	className := "ring-teal-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// RingOffsetTeal applies ring-offset-teal-shade utility
+func RingOffsetTeal(shade Shade) Class {
+	className := "ring-offset-teal-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// OutlineTeal applies outline-teal-shade utility
+func OutlineTeal(shade Shade) Class {
+	className := "outline-teal-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// FromTeal applies from-teal-shade gradient stop utility
+func FromTeal(shade Shade) Class {
+	className := "from-teal-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ViaTeal applies via-teal-shade gradient stop utility
+func ViaTeal(shade Shade) Class {
+	className := "via-teal-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ToTeal applies to-teal-shade gradient stop utility
+func ToTeal(shade Shade) Class {
+	className := "to-teal-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BgViolet applies bg-violet-shade utility
+func BgViolet(shade Shade) Class {
+	className := "bg-violet-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// TextViolet applies text-violet-shade utility
+func TextViolet(shade Shade) Class {
+	className := "text-violet-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderViolet applies border-violet-shade utility
+func BorderViolet(shade Shade) Class {
+	className := "border-violet-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderTViolet applies border-t-violet-shade utility
+func BorderTViolet(shade Shade) Class {
+	className := "border-t-violet-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderRViolet applies border-r-violet-shade utility
+func BorderRViolet(shade Shade) Class {
+	className := "border-r-violet-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderBViolet applies border-b-violet-shade utility
+func BorderBViolet(shade Shade) Class {
+	className := "border-b-violet-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BorderLViolet applies border-l-violet-shade utility
+func BorderLViolet(shade Shade) Class {
+	className := "border-l-violet-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// DivideViolet applies divide-violet-shade utility
+func DivideViolet(shade Shade) Class {
+	className := "divide-violet-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// RingViolet applies ring-violet-shade utility
+func RingViolet(shade Shade) Class {
+	className := "ring-violet-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// RingOffsetViolet applies ring-offset-violet-shade utility
+func RingOffsetViolet(shade Shade) Class {
+	className := "ring-offset-violet-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// OutlineViolet applies outline-violet-shade utility
+func OutlineViolet(shade Shade) Class {
+	className := "outline-violet-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// FromViolet applies from-violet-shade gradient stop utility
+func FromViolet(shade Shade) Class {
+	className := "from-violet-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ViaViolet applies via-violet-shade gradient stop utility
+func ViaViolet(shade Shade) Class {
+	className := "via-violet-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ToViolet applies to-violet-shade gradient stop utility
+func ToViolet(shade Shade) Class {
+	className := "to-violet-" + shade.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BgWhite applies bg-white utility
+func BgWhite() Class {
+	trackClass("bg-white")
+	return "bg-white"
+}
+
+// TextWhite applies text-white utility
+func TextWhite() Class {
+	trackClass("text-white")
+	return "text-white"
+}
+
+// BorderWhite applies border-white utility
+func BorderWhite() Class {
+	trackClass("border-white")
+	return "border-white"
+}
+
+// BorderTWhite applies border-t-white utility
+func BorderTWhite() Class {
+	trackClass("border-t-white")
+	return "border-t-white"
+}
+
+// BorderRWhite applies border-r-white utility
+func BorderRWhite() Class {
+	trackClass("border-r-white")
+	return "border-r-white"
+}
+
+// BorderBWhite applies border-b-white utility
+func BorderBWhite() Class {
+	trackClass("border-b-white")
+	return "border-b-white"
+}
+
+// BorderLWhite applies border-l-white utility
+func BorderLWhite() Class {
+	trackClass("border-l-white")
+	return "border-l-white"
+}
+
+// DivideWhite applies divide-white utility
+func DivideWhite() Class {
+	trackClass("divide-white")
+	return "divide-white"
+}
+
+// RingWhite applies ring-white utility
+func RingWhite() Class {
+	trackClass("ring-white")
+	return "ring-white"
+}
+
+// RingOffsetWhite applies ring-offset-white utility
+func RingOffsetWhite() Class {
+	trackClass("ring-offset-white")
+	return "ring-offset-white"
 }
 
-// BgStone applies bg-stone-shade utility
-func BgStone(shade int) Class {
-	className := fmt.Sprintf("bg-stone-%d", shade)
-	trackClass(className)
-	return Class(className)
+// OutlineWhite applies outline-white utility
+func OutlineWhite() Class {
+	trackClass("outline-white")
+	return "outline-white"
 }
 
-// TextStone applies text-stone-shade utility
-func TextStone(shade int) Class {
-	className := fmt.Sprintf("text-stone-%d", shade)
-	trackClass(className)
-	return Class(className)
+// FromWhite applies from-white gradient stop utility
+func FromWhite() Class {
+	trackClass("from-white")
+	return "from-white"
 }
 
-// BgWhite applies bg-white-shade utility
-func BgWhite(shade int) Class {
-	className := fmt.Sprintf("bg-white-%d", shade)
-	trackClass(className)
-	return Class(className)
+// ViaWhite applies via-white gradient stop utility
+func ViaWhite() Class {
+	trackClass("via-white")
+	return "via-white"
 }
 
-// TextWhite applies text-white-shade utility
-func TextWhite(shade int) Class {
-	className := fmt.Sprintf("text-white-%d", shade)
-	trackClass(className)
-	return Class(className)
+// ToWhite applies to-white gradient stop utility
+func ToWhite() Class {
+	trackClass("to-white")
+	return "to-white"
 }
 
-// BgLime applies bg-lime-shade utility
-func BgLime(shade int) Class {
-	className := fmt.Sprintf("bg-lime-%d", shade)
+// BgYellow applies bg-yellow-shade utility
+func BgYellow(shade Shade) Class {
+	className := "bg-yellow-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextLime applies text-lime-shade utility
-func TextLime(shade int) Class {
-	className := fmt.Sprintf("text-lime-%d", shade)
+// TextYellow applies text-yellow-shade utility
+func TextYellow(shade Shade) Class {
+	className := "text-yellow-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgGreen applies bg-green-shade utility
-func BgGreen(shade int) Class {
-	className := fmt.Sprintf("bg-green-%d", shade)
+// BorderYellow applies border-yellow-shade utility
+func BorderYellow(shade Shade) Class {
+	className := "border-yellow-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextGreen applies text-green-shade utility
-func TextGreen(shade int) Class {
-	className := fmt.Sprintf("text-green-%d", shade)
+// BorderTYellow applies border-t-yellow-shade utility
+func BorderTYellow(shade Shade) Class {
+	className := "border-t-yellow-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgTeal applies bg-teal-shade utility
-func BgTeal(shade int) Class {
-	className := fmt.Sprintf("bg-teal-%d", shade)
+// BorderRYellow applies border-r-yellow-shade utility
+func BorderRYellow(shade Shade) Class {
+	className := "border-r-yellow-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextTeal applies text-teal-shade utility
-func TextTeal(shade int) Class {
-	className := fmt.Sprintf("text-teal-%d", shade)
+// BorderBYellow applies border-b-yellow-shade utility
+func BorderBYellow(shade Shade) Class {
+	className := "border-b-yellow-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgPurple applies bg-purple-shade utility
-func BgPurple(shade int) Class {
-	className := fmt.Sprintf("bg-purple-%d", shade)
+// BorderLYellow applies border-l-yellow-shade utility
+func BorderLYellow(shade Shade) Class {
+	className := "border-l-yellow-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextPurple applies text-purple-shade utility
-func TextPurple(shade int) Class {
-	className := fmt.Sprintf("text-purple-%d", shade)
+// DivideYellow applies divide-yellow-shade utility
+func DivideYellow(shade Shade) Class {
+	className := "divide-yellow-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgWhite applies bg-white-shade utility
-func BgWhite(shade int) Class {
-	className := fmt.Sprintf("bg-white-%d", shade)
+// RingYellow applies ring-yellow-shade utility
+func RingYellow(shade Shade) Class {
+	className := "ring-yellow-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextWhite applies text-white-shade utility
-func TextWhite(shade int) Class {
-	className := fmt.Sprintf("text-white-%d", shade)
+// RingOffsetYellow applies ring-offset-yellow-shade utility
+func RingOffsetYellow(shade Shade) Class {
+	className := "ring-offset-yellow-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgLime applies bg-lime-shade utility
-func BgLime(shade int) Class {
-	className := fmt.Sprintf("bg-lime-%d", shade)
+// OutlineYellow applies outline-yellow-shade utility
+func OutlineYellow(shade Shade) Class {
+	className := "outline-yellow-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextLime applies text-lime-shade utility
-func TextLime(shade int) Class {
-	className := fmt.Sprintf("text-lime-%d", shade)
+// FromYellow applies from-yellow-shade gradient stop utility
+func FromYellow(shade Shade) Class {
+	className := "from-yellow-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgGreen applies bg-green-shade utility
-func BgGreen(shade int) Class {
-	className := fmt.Sprintf("bg-green-%d", shade)
+// ViaYellow applies via-yellow-shade gradient stop utility
+func ViaYellow(shade Shade) Class {
+	className := "via-yellow-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextGreen applies text-green-shade utility
-func TextGreen(shade int) Class {
-	className := fmt.Sprintf("text-green-%d", shade)
+// ToYellow applies to-yellow-shade gradient stop utility
+func ToYellow(shade Shade) Class {
+	className := "to-yellow-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgTeal applies bg-teal-shade utility
-func BgTeal(shade int) Class {
-	className := fmt.Sprintf("bg-teal-%d", shade)
+// BgZinc applies bg-zinc-shade utility
+func BgZinc(shade Shade) Class {
+	className := "bg-zinc-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextTeal applies text-teal-shade utility
-func TextTeal(shade int) Class {
-	className := fmt.Sprintf("text-teal-%d", shade)
+// TextZinc applies text-zinc-shade utility
+func TextZinc(shade Shade) Class {
+	className := "text-zinc-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgIndigo applies bg-indigo-shade utility
-func BgIndigo(shade int) Class {
-	className := fmt.Sprintf("bg-indigo-%d", shade)
+// BorderZinc applies border-zinc-shade utility
+func BorderZinc(shade Shade) Class {
+	className := "border-zinc-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextIndigo applies text-indigo-shade utility
-func TextIndigo(shade int) Class {
-	className := fmt.Sprintf("text-indigo-%d", shade)
+// BorderTZinc applies border-t-zinc-shade utility
+func BorderTZinc(shade Shade) Class {
+	className := "border-t-zinc-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgBlack applies bg-black-shade utility
-func BgBlack(shade int) Class {
-	className := fmt.Sprintf("bg-black-%d", shade)
+// BorderRZinc applies border-r-zinc-shade utility
+func BorderRZinc(shade Shade) Class {
+	className := "border-r-zinc-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextBlack applies text-black-shade utility
-func TextBlack(shade int) Class {
-	className := fmt.Sprintf("text-black-%d", shade)
+// BorderBZinc applies border-b-zinc-shade utility
+func BorderBZinc(shade Shade) Class {
+	className := "border-b-zinc-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgEmerald applies bg-emerald-shade utility
-func BgEmerald(shade int) Class {
-	className := fmt.Sprintf("bg-emerald-%d", shade)
+// BorderLZinc applies border-l-zinc-shade utility
+func BorderLZinc(shade Shade) Class {
+	className := "border-l-zinc-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextEmerald applies text-emerald-shade utility
-func TextEmerald(shade int) Class {
-	className := fmt.Sprintf("text-emerald-%d", shade)
+// DivideZinc applies divide-zinc-shade utility
+func DivideZinc(shade Shade) Class {
+	className := "divide-zinc-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgViolet applies bg-violet-shade utility
-func BgViolet(shade int) Class {
-	className := fmt.Sprintf("bg-violet-%d", shade)
+// RingZinc applies ring-zinc-shade utility
+func RingZinc(shade Shade) Class {
+	className := "ring-zinc-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextViolet applies text-violet-shade utility
-func TextViolet(shade int) Class {
-	className := fmt.Sprintf("text-violet-%d", shade)
+// RingOffsetZinc applies ring-offset-zinc-shade utility
+func RingOffsetZinc(shade Shade) Class {
+	className := "ring-offset-zinc-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgPurple applies bg-purple-shade utility
-func BgPurple(shade int) Class {
-	className := fmt.Sprintf("bg-purple-%d", shade)
+// OutlineZinc applies outline-zinc-shade utility
+func OutlineZinc(shade Shade) Class {
+	className := "outline-zinc-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextPurple applies text-purple-shade utility
-func TextPurple(shade int) Class {
-	className := fmt.Sprintf("text-purple-%d", shade)
+// FromZinc applies from-zinc-shade gradient stop utility
+func FromZinc(shade Shade) Class {
+	className := "from-zinc-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// BgGray applies bg-gray-shade utility
-func BgGray(shade int) Class {
-	className := fmt.Sprintf("bg-gray-%d", shade)
+// ViaZinc applies via-zinc-shade gradient stop utility
+func ViaZinc(shade Shade) Class {
+	className := "via-zinc-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// TextGray applies text-gray-shade utility
-func TextGray(shade int) Class {
-	className := fmt.Sprintf("text-gray-%d", shade)
+// ToZinc applies to-zinc-shade gradient stop utility
+func ToZinc(shade Shade) Class {
+	className := "to-zinc-" + shade.String()
 	trackClass(className)
 	return Class(className)
 }
@@ -819,6 +5148,12 @@ func InlineGrid() Class {
 	return "inline-grid"
 }
 
+// Container applies container utility
+func Container() Class {
+	trackClass("container")
+	return "container"
+}
+
 // JustifyStart applies justify-start utility
 func JustifyStart() Class {
 	trackClass("justify-start")
@@ -909,10 +5244,67 @@ func FlexColReverse() Class {
 	return "flex-col-reverse"
 }
 
-// TextLg applies text-lg utility
-func TextLg() Class {
-	trackClass("text-lg")
-	return "text-lg"
+// FlexWrap applies flex-wrap utility
+func FlexWrap() Class {
+	trackClass("flex-wrap")
+	return "flex-wrap"
+}
+
+// FlexNowrap applies flex-nowrap utility
+func FlexNowrap() Class {
+	trackClass("flex-nowrap")
+	return "flex-nowrap"
+}
+
+// FlexWrapReverse applies flex-wrap-reverse utility
+func FlexWrapReverse() Class {
+	trackClass("flex-wrap-reverse")
+	return "flex-wrap-reverse"
+}
+
+// Flex1 applies flex-1 utility
+func Flex1() Class {
+	trackClass("flex-1")
+	return "flex-1"
+}
+
+// FlexAuto applies flex-auto utility
+func FlexAuto() Class {
+	trackClass("flex-auto")
+	return "flex-auto"
+}
+
+// FlexInitial applies flex-initial utility
+func FlexInitial() Class {
+	trackClass("flex-initial")
+	return "flex-initial"
+}
+
+// FlexNone applies flex-none utility
+func FlexNone() Class {
+	trackClass("flex-none")
+	return "flex-none"
+}
+
+// GridCols applies grid-cols utility
+func GridCols(count GridTracks) Class {
+	className := "grid-cols-" + count.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// GridRows applies grid-rows utility
+func GridRows(count GridTracks) Class {
+	className := "grid-rows-" + count.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// Gap applies gap utility
+func Gap(size Spacing) Class {
+	className := "gap-" + size.String()
+	trackClass(className)
+	return Class(className)
 }
 
 // Text2XL applies text-2xl utility
@@ -921,6 +5313,12 @@ func Text2XL() Class {
 	return "text-2xl"
 }
 
+// Text3XL applies text-3xl utility
+func Text3XL() Class {
+	trackClass("text-3xl")
+	return "text-3xl"
+}
+
 // Text4xl applies text-4xl utility
 func Text4xl() Class {
 	trackClass("text-4xl")
@@ -933,34 +5331,34 @@ func Text5xl() Class {
 	return "text-5xl"
 }
 
+// Text6xl applies text-6xl utility
+func Text6xl() Class {
+	trackClass("text-6xl")
+	return "text-6xl"
+}
+
 // Text7xl applies text-7xl utility
 func Text7xl() Class {
 	trackClass("text-7xl")
 	return "text-7xl"
 }
 
-// TextXs applies text-xs utility
-func TextXs() Class {
-	trackClass("text-xs")
-	return "text-xs"
-}
-
-// TextSm applies text-sm utility
-func TextSm() Class {
-	trackClass("text-sm")
-	return "text-sm"
+// Text8xl applies text-8xl utility
+func Text8xl() Class {
+	trackClass("text-8xl")
+	return "text-8xl"
 }
 
-// TextXs applies text-xs utility
-func TextXs() Class {
-	trackClass("text-xs")
-	return "text-xs"
+// Text9xl applies text-9xl utility
+func Text9xl() Class {
+	trackClass("text-9xl")
+	return "text-9xl"
 }
 
-// TextSm applies text-sm utility
-func TextSm() Class {
-	trackClass("text-sm")
-	return "text-sm"
+// TextBase applies text-base utility
+func TextBase() Class {
+	trackClass("text-base")
+	return "text-base"
 }
 
 // TextLg applies text-lg utility
@@ -969,22 +5367,10 @@ func TextLg() Class {
 	return "text-lg"
 }
 
-// TextXl applies text-xl utility
-func TextXl() Class {
-	trackClass("text-xl")
-	return "text-xl"
-}
-
-// Text9xl applies text-9xl utility
-func Text9xl() Class {
-	trackClass("text-9xl")
-	return "text-9xl"
-}
-
-// Text8xl applies text-8xl utility
-func Text8xl() Class {
-	trackClass("text-8xl")
-	return "text-8xl"
+// TextSm applies text-sm utility
+func TextSm() Class {
+	trackClass("text-sm")
+	return "text-sm"
 }
 
 // TextXl applies text-xl utility
@@ -993,34 +5379,10 @@ func TextXl() Class {
 	return "text-xl"
 }
 
-// Text3XL applies text-3xl utility
-func Text3XL() Class {
-	trackClass("text-3xl")
-	return "text-3xl"
-}
-
-// TextBase applies text-base utility
-func TextBase() Class {
-	trackClass("text-base")
-	return "text-base"
-}
-
-// Text4xl applies text-4xl utility
-func Text4xl() Class {
-	trackClass("text-4xl")
-	return "text-4xl"
-}
-
-// Text5xl applies text-5xl utility
-func Text5xl() Class {
-	trackClass("text-5xl")
-	return "text-5xl"
-}
-
-// Text6xl applies text-6xl utility
-func Text6xl() Class {
-	trackClass("text-6xl")
-	return "text-6xl"
+// TextXs applies text-xs utility
+func TextXs() Class {
+	trackClass("text-xs")
+	return "text-xs"
 }
 
 // TextLeft applies text-left utility
@@ -1119,100 +5481,124 @@ func FontMono() Class {
 	return "font-mono"
 }
 
+// Underline applies underline utility
+func Underline() Class {
+	trackClass("underline")
+	return "underline"
+}
+
+// Overline applies overline utility
+func Overline() Class {
+	trackClass("overline")
+	return "overline"
+}
+
+// LineThrough applies line-through utility
+func LineThrough() Class {
+	trackClass("line-through")
+	return "line-through"
+}
+
+// NoUnderline applies no-underline utility
+func NoUnderline() Class {
+	trackClass("no-underline")
+	return "no-underline"
+}
+
 // Border applies border utility
-func Border(width int) Class {
-	className := fmt.Sprintf("border-%d", width)
+func Border(width BorderWidth) Class {
+	className := "border-" + width.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // BorderT applies border-t utility
-func BorderT(width int) Class {
-	className := fmt.Sprintf("border-t-%d", width)
+func BorderT(width BorderWidth) Class {
+	className := "border-t-" + width.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // BorderR applies border-r utility
-func BorderR(width int) Class {
-	className := fmt.Sprintf("border-r-%d", width)
+func BorderR(width BorderWidth) Class {
+	className := "border-r-" + width.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // BorderB applies border-b utility
-func BorderB(width int) Class {
-	className := fmt.Sprintf("border-b-%d", width)
+func BorderB(width BorderWidth) Class {
+	className := "border-b-" + width.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // BorderL applies border-l utility
-func BorderL(width int) Class {
-	className := fmt.Sprintf("border-l-%d", width)
+func BorderL(width BorderWidth) Class {
+	className := "border-l-" + width.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // Rounded applies rounded utility
-func Rounded(radius int) Class {
-	className := fmt.Sprintf("rounded-%d", radius)
+func Rounded(radius Radius) Class {
+	className := "rounded-" + radius.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // RoundedT applies rounded-t utility
-func RoundedT(radius int) Class {
-	className := fmt.Sprintf("rounded-t-%d", radius)
+func RoundedT(radius Radius) Class {
+	className := "rounded-t-" + radius.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // RoundedR applies rounded-r utility
-func RoundedR(radius int) Class {
-	className := fmt.Sprintf("rounded-r-%d", radius)
+func RoundedR(radius Radius) Class {
+	className := "rounded-r-" + radius.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // RoundedB applies rounded-b utility
-func RoundedB(radius int) Class {
-	className := fmt.Sprintf("rounded-b-%d", radius)
+func RoundedB(radius Radius) Class {
+	className := "rounded-b-" + radius.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // RoundedL applies rounded-l utility
-func RoundedL(radius int) Class {
-	className := fmt.Sprintf("rounded-l-%d", radius)
+func RoundedL(radius Radius) Class {
+	className := "rounded-l-" + radius.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // RoundedTl applies rounded-tl utility
-func RoundedTl(radius int) Class {
-	className := fmt.Sprintf("rounded-tl-%d", radius)
+func RoundedTl(radius Radius) Class {
+	className := "rounded-tl-" + radius.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // RoundedTr applies rounded-tr utility
-func RoundedTr(radius int) Class {
-	className := fmt.Sprintf("rounded-tr-%d", radius)
+func RoundedTr(radius Radius) Class {
+	className := "rounded-tr-" + radius.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // RoundedBr applies rounded-br utility
-func RoundedBr(radius int) Class {
-	className := fmt.Sprintf("rounded-br-%d", radius)
+func RoundedBr(radius Radius) Class {
+	className := "rounded-br-" + radius.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // RoundedBl applies rounded-bl utility
-func RoundedBl(radius int) Class {
-	className := fmt.Sprintf("rounded-bl-%d", radius)
+func RoundedBl(radius Radius) Class {
+	className := "rounded-bl-" + radius.String()
 	trackClass(className)
 	return Class(className)
 }
@@ -1229,44 +5615,197 @@ func RoundedNone() Class {
 	return "rounded-none"
 }
 
+// BorderSolid applies border-solid utility
+func BorderSolid() Class {
+	trackClass("border-solid")
+	return "border-solid"
+}
+
+// BorderDashed applies border-dashed utility
+func BorderDashed() Class {
+	trackClass("border-dashed")
+	return "border-dashed"
+}
+
+// BorderDotted applies border-dotted utility
+func BorderDotted() Class {
+	trackClass("border-dotted")
+	return "border-dotted"
+}
+
+// BorderDouble applies border-double utility
+func BorderDouble() Class {
+	trackClass("border-double")
+	return "border-double"
+}
+
+// BorderNone applies border-none utility
+func BorderNone() Class {
+	trackClass("border-none")
+	return "border-none"
+}
+
+// DivideX applies divide-x utility to the borders between children
+func DivideX(width ...BorderWidth) Class {
+	var className string
+	if len(width) > 0 {
+		className = "divide-x-" + width[0].String()
+	} else {
+		className = "divide-x"
+	}
+	trackClass(className)
+	return Class(className)
+}
+
+// DivideY applies divide-y utility to the borders between children
+func DivideY(width ...BorderWidth) Class {
+	var className string
+	if len(width) > 0 {
+		className = "divide-y-" + width[0].String()
+	} else {
+		className = "divide-y"
+	}
+	trackClass(className)
+	return Class(className)
+}
+
+// DivideSolid applies divide-solid utility
+func DivideSolid() Class {
+	trackClass("divide-solid")
+	return "divide-solid"
+}
+
+// DivideDashed applies divide-dashed utility
+func DivideDashed() Class {
+	trackClass("divide-dashed")
+	return "divide-dashed"
+}
+
+// DivideDotted applies divide-dotted utility
+func DivideDotted() Class {
+	trackClass("divide-dotted")
+	return "divide-dotted"
+}
+
+// DivideDouble applies divide-double utility
+func DivideDouble() Class {
+	trackClass("divide-double")
+	return "divide-double"
+}
+
+// DivideNone applies divide-none utility
+func DivideNone() Class {
+	trackClass("divide-none")
+	return "divide-none"
+}
+
+// Ring applies ring utility
+func Ring(width ...RingWidth) Class {
+	var className string
+	if len(width) > 0 {
+		className = "ring-" + width[0].String()
+	} else {
+		className = "ring"
+	}
+	trackClass(className)
+	return Class(className)
+}
+
+// RingOffset applies ring-offset utility
+func RingOffset(width RingWidth) Class {
+	className := "ring-offset-" + width.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// RingInset applies ring-inset utility
+func RingInset() Class {
+	trackClass("ring-inset")
+	return "ring-inset"
+}
+
+// OutlineWidth applies outline width utility
+func OutlineWidth(width OutlineSize) Class {
+	className := "outline-" + width.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// OutlineOffset applies outline-offset utility
+func OutlineOffset(offset OutlineSize) Class {
+	className := "outline-offset-" + offset.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// OutlineNone applies outline-none utility
+func OutlineNone() Class {
+	trackClass("outline-none")
+	return "outline-none"
+}
+
+// Outline applies outline utility
+func Outline() Class {
+	trackClass("outline")
+	return "outline"
+}
+
+// OutlineDashed applies outline-dashed utility
+func OutlineDashed() Class {
+	trackClass("outline-dashed")
+	return "outline-dashed"
+}
+
+// OutlineDotted applies outline-dotted utility
+func OutlineDotted() Class {
+	trackClass("outline-dotted")
+	return "outline-dotted"
+}
+
+// OutlineDouble applies outline-double utility
+func OutlineDouble() Class {
+	trackClass("outline-double")
+	return "outline-double"
+}
+
 // W applies width utility
-func W(size string) Class {
-	className := fmt.Sprintf("w-%s", size)
+func W(size Width) Class {
+	className := "w-" + size.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // H applies height utility
-func H(size string) Class {
-	className := fmt.Sprintf("h-%s", size)
+func H(size Height) Class {
+	className := "h-" + size.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // MaxW applies max-width utility
-func MaxW(size string) Class {
-	className := fmt.Sprintf("max-w-%s", size)
+func MaxW(size MaxWidth) Class {
+	className := "max-w-" + size.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // MinW applies min-width utility
-func MinW(size string) Class {
-	className := fmt.Sprintf("min-w-%s", size)
+func MinW(size MinWidth) Class {
+	className := "min-w-" + size.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // MaxH applies max-height utility
-func MaxH(size string) Class {
-	className := fmt.Sprintf("max-h-%s", size)
+func MaxH(size MaxHeight) Class {
+	className := "max-h-" + size.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // MinH applies min-height utility
-func MinH(size string) Class {
-	className := fmt.Sprintf("min-h-%s", size)
+func MinH(size MinHeight) Class {
+	className := "min-h-" + size.String()
 	trackClass(className)
 	return Class(className)
 }
@@ -1302,57 +5841,92 @@ func Sticky() Class {
 }
 
 // Top applies top position utility
-func Top(value string) Class {
-	className := fmt.Sprintf("top-%s", value)
+func Top(value Offset) Class {
+	className := "top-" + value.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// NegTop applies negative top position utility
+func NegTop(value Offset) Class {
+	className := "-top-" + value.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // Right applies right position utility
-func Right(value string) Class {
-	className := fmt.Sprintf("right-%s", value)
+func Right(value Offset) Class {
+	className := "right-" + value.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// NegRight applies negative right position utility
+func NegRight(value Offset) Class {
+	className := "-right-" + value.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // Bottom applies bottom position utility
-func Bottom(value string) Class {
-	className := fmt.Sprintf("bottom-%s", value)
+func Bottom(value Offset) Class {
+	className := "bottom-" + value.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// NegBottom applies negative bottom position utility
+func NegBottom(value Offset) Class {
+	className := "-bottom-" + value.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// Left applies left position utility
+func Left(value Offset) Class {
+	className := "left-" + value.String()
 	trackClass(className)
 	return Class(className)
 }
 
-// Left applies left position utility
-func Left(value string) Class {
-	className := fmt.Sprintf("left-%s", value)
+// NegLeft applies negative left position utility
+func NegLeft(value Offset) Class {
+	className := "-left-" + value.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // Inset applies inset utility
-func Inset(value string) Class {
-	className := fmt.Sprintf("inset-%s", value)
+func Inset(value Offset) Class {
+	className := "inset-" + value.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // InsetX applies horizontal inset utility
-func InsetX(value string) Class {
-	className := fmt.Sprintf("inset-x-%s", value)
+func InsetX(value Offset) Class {
+	className := "inset-x-" + value.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // InsetY applies vertical inset utility
-func InsetY(value string) Class {
-	className := fmt.Sprintf("inset-y-%s", value)
+func InsetY(value Offset) Class {
+	className := "inset-y-" + value.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // Z applies z-index utility
-func Z(value string) Class {
-	className := fmt.Sprintf("z-%s", value)
+func Z(value ZIndex) Class {
+	className := "z-" + value.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// NegZ applies negative z-index utility
+func NegZ(value ZIndex) Class {
+	className := "-z-" + value.String()
 	trackClass(className)
 	return Class(className)
 }
@@ -1430,17 +6004,17 @@ func OverflowYScroll() Class {
 }
 
 // Opacity applies opacity utility
-func Opacity(value int) Class {
-	className := fmt.Sprintf("opacity-%d", value)
+func Opacity(value OpacityLevel) Class {
+	className := "opacity-" + value.String()
 	trackClass(className)
 	return Class(className)
 }
 
 // Shadow applies shadow utility
-func Shadow(size ...string) Class {
+func Shadow(size ...ShadowSize) Class {
 	var className string
-	if len(size) > 0 && size[0] != "" {
-		className = fmt.Sprintf("shadow-%s", size[0])
+	if len(size) > 0 {
+		className = "shadow-" + size[0].String()
 	} else {
 		className = "shadow"
 	}
@@ -1627,3 +6201,604 @@ func NotSrOnly() Class {
 	trackClass("not-sr-only")
 	return "not-sr-only"
 }
+
+// Blur applies blur filter utility
+func Blur(value ...BlurSize) Class {
+	var className string
+	if len(value) > 0 {
+		className = "blur-" + value[0].String()
+	} else {
+		className = "blur"
+	}
+	trackClass(className)
+	return Class(className)
+}
+
+// BackdropBlur applies backdrop-blur filter utility
+func BackdropBlur(value ...BlurSize) Class {
+	var className string
+	if len(value) > 0 {
+		className = "backdrop-blur-" + value[0].String()
+	} else {
+		className = "backdrop-blur"
+	}
+	trackClass(className)
+	return Class(className)
+}
+
+// Brightness applies brightness filter utility
+func Brightness(value BrightnessLevel) Class {
+	className := "brightness-" + value.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BackdropBrightness applies backdrop-brightness filter utility
+func BackdropBrightness(value BrightnessLevel) Class {
+	className := "backdrop-brightness-" + value.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// Contrast applies contrast filter utility
+func Contrast(value ContrastLevel) Class {
+	className := "contrast-" + value.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// BackdropContrast applies backdrop-contrast filter utility
+func BackdropContrast(value ContrastLevel) Class {
+	className := "backdrop-contrast-" + value.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// Grayscale applies grayscale filter utility
+func Grayscale(value ...GrayscaleLevel) Class {
+	var className string
+	if len(value) > 0 {
+		className = "grayscale-" + value[0].String()
+	} else {
+		className = "grayscale"
+	}
+	trackClass(className)
+	return Class(className)
+}
+
+// BackdropGrayscale applies backdrop-grayscale filter utility
+func BackdropGrayscale(value ...GrayscaleLevel) Class {
+	var className string
+	if len(value) > 0 {
+		className = "backdrop-grayscale-" + value[0].String()
+	} else {
+		className = "backdrop-grayscale"
+	}
+	trackClass(className)
+	return Class(className)
+}
+
+// DropShadow applies drop-shadow filter utility
+func DropShadow(value ...DropShadowSize) Class {
+	var className string
+	if len(value) > 0 {
+		className = "drop-shadow-" + value[0].String()
+	} else {
+		className = "drop-shadow"
+	}
+	trackClass(className)
+	return Class(className)
+}
+
+// Scale applies scale transform utility
+func Scale(value ScaleFactor) Class {
+	className := "scale-" + value.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ScaleX applies scale-x transform utility
+func ScaleX(value ScaleFactor) Class {
+	className := "scale-x-" + value.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// ScaleY applies scale-y transform utility
+func ScaleY(value ScaleFactor) Class {
+	className := "scale-y-" + value.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// Rotate applies rotate transform utility
+func Rotate(value Rotation) Class {
+	className := "rotate-" + value.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// NegRotate applies negative -rotate transform utility
+func NegRotate(value Rotation) Class {
+	className := "-rotate-" + value.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// SkewX applies skew-x transform utility
+func SkewX(value SkewAngle) Class {
+	className := "skew-x-" + value.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// NegSkewX applies negative -skew-x transform utility
+func NegSkewX(value SkewAngle) Class {
+	className := "-skew-x-" + value.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// SkewY applies skew-y transform utility
+func SkewY(value SkewAngle) Class {
+	className := "skew-y-" + value.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// NegSkewY applies negative -skew-y transform utility
+func NegSkewY(value SkewAngle) Class {
+	className := "-skew-y-" + value.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// TranslateX applies translate-x transform utility
+func TranslateX(value Translation) Class {
+	className := "translate-x-" + value.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// NegTranslateX applies negative -translate-x transform utility
+func NegTranslateX(value Translation) Class {
+	className := "-translate-x-" + value.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// TranslateY applies translate-y transform utility
+func TranslateY(value Translation) Class {
+	className := "translate-y-" + value.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// NegTranslateY applies negative -translate-y transform utility
+func NegTranslateY(value Translation) Class {
+	className := "-translate-y-" + value.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// OriginCenter applies origin-center utility
+func OriginCenter() Class {
+	trackClass("origin-center")
+	return "origin-center"
+}
+
+// OriginTop applies origin-top utility
+func OriginTop() Class {
+	trackClass("origin-top")
+	return "origin-top"
+}
+
+// OriginTopRight applies origin-top-right utility
+func OriginTopRight() Class {
+	trackClass("origin-top-right")
+	return "origin-top-right"
+}
+
+// OriginRight applies origin-right utility
+func OriginRight() Class {
+	trackClass("origin-right")
+	return "origin-right"
+}
+
+// OriginBottomRight applies origin-bottom-right utility
+func OriginBottomRight() Class {
+	trackClass("origin-bottom-right")
+	return "origin-bottom-right"
+}
+
+// OriginBottom applies origin-bottom utility
+func OriginBottom() Class {
+	trackClass("origin-bottom")
+	return "origin-bottom"
+}
+
+// OriginBottomLeft applies origin-bottom-left utility
+func OriginBottomLeft() Class {
+	trackClass("origin-bottom-left")
+	return "origin-bottom-left"
+}
+
+// OriginLeft applies origin-left utility
+func OriginLeft() Class {
+	trackClass("origin-left")
+	return "origin-left"
+}
+
+// OriginTopLeft applies origin-top-left utility
+func OriginTopLeft() Class {
+	trackClass("origin-top-left")
+	return "origin-top-left"
+}
+
+// Duration applies transition duration utility
+func Duration(value Milliseconds) Class {
+	className := "duration-" + value.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// Delay applies transition delay utility
+func Delay(value Milliseconds) Class {
+	className := "delay-" + value.String()
+	trackClass(className)
+	return Class(className)
+}
+
+// TransitionNone applies transition-none utility
+func TransitionNone() Class {
+	trackClass("transition-none")
+	return "transition-none"
+}
+
+// TransitionAll applies transition-all utility
+func TransitionAll() Class {
+	trackClass("transition-all")
+	return "transition-all"
+}
+
+// Transition applies transition utility
+func Transition() Class {
+	trackClass("transition")
+	return "transition"
+}
+
+// TransitionColors applies transition-colors utility
+func TransitionColors() Class {
+	trackClass("transition-colors")
+	return "transition-colors"
+}
+
+// TransitionOpacity applies transition-opacity utility
+func TransitionOpacity() Class {
+	trackClass("transition-opacity")
+	return "transition-opacity"
+}
+
+// TransitionShadow applies transition-shadow utility
+func TransitionShadow() Class {
+	trackClass("transition-shadow")
+	return "transition-shadow"
+}
+
+// TransitionTransform applies transition-transform utility
+func TransitionTransform() Class {
+	trackClass("transition-transform")
+	return "transition-transform"
+}
+
+// EaseLinear applies ease-linear utility
+func EaseLinear() Class {
+	trackClass("ease-linear")
+	return "ease-linear"
+}
+
+// EaseIn applies ease-in utility
+func EaseIn() Class {
+	trackClass("ease-in")
+	return "ease-in"
+}
+
+// EaseOut applies ease-out utility
+func EaseOut() Class {
+	trackClass("ease-out")
+	return "ease-out"
+}
+
+// EaseInOut applies ease-in-out utility
+func EaseInOut() Class {
+	trackClass("ease-in-out")
+	return "ease-in-out"
+}
+
+// AnimateNone applies animate-none utility
+func AnimateNone() Class {
+	trackClass("animate-none")
+	return "animate-none"
+}
+
+// AnimateSpin applies animate-spin utility
+func AnimateSpin() Class {
+	trackClass("animate-spin")
+	return "animate-spin"
+}
+
+// AnimatePing applies animate-ping utility
+func AnimatePing() Class {
+	trackClass("animate-ping")
+	return "animate-ping"
+}
+
+// AnimatePulse applies animate-pulse utility
+func AnimatePulse() Class {
+	trackClass("animate-pulse")
+	return "animate-pulse"
+}
+
+// AnimateBounce applies animate-bounce utility
+func AnimateBounce() Class {
+	trackClass("animate-bounce")
+	return "animate-bounce"
+}
+
+// Hover applies the hover state variant to a utility
+func Hover(class Class) Class {
+	return Variant("hover", class)
+}
+
+// Focus applies the focus state variant to a utility
+func Focus(class Class) Class {
+	return Variant("focus", class)
+}
+
+// FocusVisible applies the focus-visible state variant to a utility
+func FocusVisible(class Class) Class {
+	return Variant("focus-visible", class)
+}
+
+// FocusWithin applies the focus-within state variant to a utility
+func FocusWithin(class Class) Class {
+	return Variant("focus-within", class)
+}
+
+// Active applies the active state variant to a utility
+func Active(class Class) Class {
+	return Variant("active", class)
+}
+
+// Disabled applies the disabled state variant to a utility
+func Disabled(class Class) Class {
+	return Variant("disabled", class)
+}
+
+// Visited applies the visited state variant to a utility
+func Visited(class Class) Class {
+	return Variant("visited", class)
+}
+
+// Sm applies a utility from the sm breakpoint (min-width: 640px) up
+func Sm(class Class) Class {
+	return Variant("sm", class)
+}
+
+// Md applies a utility from the md breakpoint (min-width: 768px) up
+func Md(class Class) Class {
+	return Variant("md", class)
+}
+
+// Lg applies a utility from the lg breakpoint (min-width: 1024px) up
+func Lg(class Class) Class {
+	return Variant("lg", class)
+}
+
+// Xl applies a utility from the xl breakpoint (min-width: 1280px) up
+func Xl(class Class) Class {
+	return Variant("xl", class)
+}
+
+// Xl2 applies a utility from the 2xl breakpoint (min-width: 1536px) up
+func Xl2(class Class) Class {
+	return Variant("2xl", class)
+}
+
+// WArb applies w-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func WArb(value string) Class {
+	return Arb("w", value)
+}
+
+// HArb applies h-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func HArb(value string) Class {
+	return Arb("h", value)
+}
+
+// MinWArb applies min-w-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func MinWArb(value string) Class {
+	return Arb("min-w", value)
+}
+
+// MaxWArb applies max-w-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func MaxWArb(value string) Class {
+	return Arb("max-w", value)
+}
+
+// MinHArb applies min-h-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func MinHArb(value string) Class {
+	return Arb("min-h", value)
+}
+
+// MaxHArb applies max-h-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func MaxHArb(value string) Class {
+	return Arb("max-h", value)
+}
+
+// PArb applies p-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func PArb(value string) Class {
+	return Arb("p", value)
+}
+
+// PxArb applies px-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func PxArb(value string) Class {
+	return Arb("px", value)
+}
+
+// PyArb applies py-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func PyArb(value string) Class {
+	return Arb("py", value)
+}
+
+// PtArb applies pt-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func PtArb(value string) Class {
+	return Arb("pt", value)
+}
+
+// PrArb applies pr-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func PrArb(value string) Class {
+	return Arb("pr", value)
+}
+
+// PbArb applies pb-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func PbArb(value string) Class {
+	return Arb("pb", value)
+}
+
+// PlArb applies pl-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func PlArb(value string) Class {
+	return Arb("pl", value)
+}
+
+// MArb applies m-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func MArb(value string) Class {
+	return Arb("m", value)
+}
+
+// MxArb applies mx-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func MxArb(value string) Class {
+	return Arb("mx", value)
+}
+
+// MyArb applies my-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func MyArb(value string) Class {
+	return Arb("my", value)
+}
+
+// MtArb applies mt-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func MtArb(value string) Class {
+	return Arb("mt", value)
+}
+
+// MrArb applies mr-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func MrArb(value string) Class {
+	return Arb("mr", value)
+}
+
+// MbArb applies mb-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func MbArb(value string) Class {
+	return Arb("mb", value)
+}
+
+// MlArb applies ml-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func MlArb(value string) Class {
+	return Arb("ml", value)
+}
+
+// GapArb applies gap-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func GapArb(value string) Class {
+	return Arb("gap", value)
+}
+
+// BgArb applies bg-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func BgArb(value string) Class {
+	return Arb("bg", value)
+}
+
+// TextArb applies text-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func TextArb(value string) Class {
+	return Arb("text", value)
+}
+
+// RoundedArb applies rounded-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func RoundedArb(value string) Class {
+	return Arb("rounded", value)
+}
+
+// GridColsArb applies grid-cols-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func GridColsArb(value string) Class {
+	return Arb("grid-cols", value)
+}
+
+// GridRowsArb applies grid-rows-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func GridRowsArb(value string) Class {
+	return Arb("grid-rows", value)
+}
+
+// TopArb applies top-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func TopArb(value string) Class {
+	return Arb("top", value)
+}
+
+// RightArb applies right-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func RightArb(value string) Class {
+	return Arb("right", value)
+}
+
+// BottomArb applies bottom-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func BottomArb(value string) Class {
+	return Arb("bottom", value)
+}
+
+// LeftArb applies left-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func LeftArb(value string) Class {
+	return Arb("left", value)
+}
+
+// InsetArb applies inset-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func InsetArb(value string) Class {
+	return Arb("inset", value)
+}
+
+// ZArb applies z-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func ZArb(value string) Class {
+	return Arb("z", value)
+}
+
+// OpacityArb applies opacity-[value] with an arbitrary value. It panics if the
+// value is rejected; see Arb.
+func OpacityArb(value string) Class {
+	return Arb("opacity", value)
+}
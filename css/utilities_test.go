@@ -1,7 +1,15 @@
 package css_test
 
 import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/computesdk/zforge/css"
@@ -9,31 +17,31 @@ import (
 )
 
 func TestClassString(t *testing.T) {
-	class := css.P(4)
+	class := css.P(css.Spacing4())
 	assert.Equal(t, "p-4", class.String())
 }
 
 func TestSpacingUtilities(t *testing.T) {
 	tests := []struct {
 		name     string
-		fn       func(int) css.Class
-		value    int
+		fn       func(css.Spacing) css.Class
+		value    css.Spacing
 		expected string
 	}{
-		{"padding", css.P, 4, "p-4"},
-		{"padding-x", css.Px, 2, "px-2"},
-		{"padding-y", css.Py, 8, "py-8"},
-		{"padding-top", css.Pt, 1, "pt-1"},
-		{"padding-right", css.Pr, 3, "pr-3"},
-		{"padding-bottom", css.Pb, 6, "pb-6"},
-		{"padding-left", css.Pl, 0, "pl-0"},
-		{"margin", css.M, 4, "m-4"},
-		{"margin-x", css.Mx, 2, "mx-2"},
-		{"margin-y", css.My, 8, "my-8"},
-		{"margin-top", css.Mt, 1, "mt-1"},
-		{"margin-right", css.Mr, 3, "mr-3"},
-		{"margin-bottom", css.Mb, 6, "mb-6"},
-		{"margin-left", css.Ml, 0, "ml-0"},
+		{"padding", css.P, css.Spacing4(), "p-4"},
+		{"padding-x", css.Px, css.Spacing2(), "px-2"},
+		{"padding-y", css.Py, css.Spacing8(), "py-8"},
+		{"padding-top", css.Pt, css.Spacing1(), "pt-1"},
+		{"padding-right", css.Pr, css.Spacing3(), "pr-3"},
+		{"padding-bottom", css.Pb, css.Spacing6(), "pb-6"},
+		{"padding-left", css.Pl, css.Spacing0(), "pl-0"},
+		{"margin", css.M, css.Spacing4(), "m-4"},
+		{"margin-x", css.Mx, css.Spacing2(), "mx-2"},
+		{"margin-y", css.My, css.Spacing8(), "my-8"},
+		{"margin-top", css.Mt, css.Spacing1(), "mt-1"},
+		{"margin-right", css.Mr, css.Spacing3(), "mr-3"},
+		{"margin-bottom", css.Mb, css.Spacing6(), "mb-6"},
+		{"margin-left", css.Ml, css.Spacing0(), "ml-0"},
 	}
 
 	for _, tt := range tests {
@@ -47,20 +55,20 @@ func TestSpacingUtilities(t *testing.T) {
 func TestColorUtilities(t *testing.T) {
 	tests := []struct {
 		name     string
-		bgFn     func(int) css.Class
-		textFn   func(int) css.Class
-		shade    int
+		bgFn     func(css.Shade) css.Class
+		textFn   func(css.Shade) css.Class
+		shade    css.Shade
 		bgExp    string
 		textExp  string
 	}{
-		{"indigo", css.BgIndigo, css.TextIndigo, 500, "bg-indigo-500", "text-indigo-500"},
-		{"purple", css.BgPurple, css.TextPurple, 700, "bg-purple-700", "text-purple-700"},
-		{"rose", css.BgRose, css.TextRose, 300, "bg-rose-300", "text-rose-300"},
-		{"amber", css.BgAmber, css.TextAmber, 400, "bg-amber-400", "text-amber-400"},
-		{"green", css.BgGreen, css.TextGreen, 600, "bg-green-600", "text-green-600"},
-		{"blue", css.BgBlue, css.TextBlue, 50, "bg-blue-50", "text-blue-50"},
-		{"red", css.BgRed, css.TextRed, 900, "bg-red-900", "text-red-900"},
-		{"gray", css.BgGray, css.TextGray, 500, "bg-gray-500", "text-gray-500"},
+		{"indigo", css.BgIndigo, css.TextIndigo, css.Shade500(), "bg-indigo-500", "text-indigo-500"},
+		{"purple", css.BgPurple, css.TextPurple, css.Shade700(), "bg-purple-700", "text-purple-700"},
+		{"rose", css.BgRose, css.TextRose, css.Shade300(), "bg-rose-300", "text-rose-300"},
+		{"amber", css.BgAmber, css.TextAmber, css.Shade400(), "bg-amber-400", "text-amber-400"},
+		{"green", css.BgGreen, css.TextGreen, css.Shade600(), "bg-green-600", "text-green-600"},
+		{"blue", css.BgBlue, css.TextBlue, css.Shade50(), "bg-blue-50", "text-blue-50"},
+		{"red", css.BgRed, css.TextRed, css.Shade900(), "bg-red-900", "text-red-900"},
+		{"gray", css.BgGray, css.TextGray, css.Shade500(), "bg-gray-500", "text-gray-500"},
 	}
 
 	for _, tt := range tests {
@@ -165,24 +173,15 @@ func TestTypographyUtilities(t *testing.T) {
 func TestBorderUtilities(t *testing.T) {
 	tests := []struct {
 		name     string
-		fn       func(int) css.Class
-		value    int
+		fn       func(css.BorderWidth) css.Class
+		value    css.BorderWidth
 		expected string
 	}{
-		{"border", css.Border, 2, "border-2"},
-		{"border-t", css.BorderT, 1, "border-t-1"},
-		{"border-r", css.BorderR, 4, "border-r-4"},
-		{"border-b", css.BorderB, 0, "border-b-0"},
-		{"border-l", css.BorderL, 8, "border-l-8"},
-		{"rounded", css.Rounded, 4, "rounded-4"},
-		{"rounded-t", css.RoundedT, 2, "rounded-t-2"},
-		{"rounded-r", css.RoundedR, 6, "rounded-r-6"},
-		{"rounded-b", css.RoundedB, 8, "rounded-b-8"},
-		{"rounded-l", css.RoundedL, 1, "rounded-l-1"},
-		{"rounded-tl", css.RoundedTl, 3, "rounded-tl-3"},
-		{"rounded-tr", css.RoundedTr, 5, "rounded-tr-5"},
-		{"rounded-br", css.RoundedBr, 7, "rounded-br-7"},
-		{"rounded-bl", css.RoundedBl, 9, "rounded-bl-9"},
+		{"border", css.Border, css.BorderWidth2(), "border-2"},
+		{"border-t", css.BorderT, css.BorderWidth1(), "border-t-1"},
+		{"border-r", css.BorderR, css.BorderWidth4(), "border-r-4"},
+		{"border-b", css.BorderB, css.BorderWidth0(), "border-b-0"},
+		{"border-l", css.BorderL, css.BorderWidth8(), "border-l-8"},
 	}
 
 	for _, tt := range tests {
@@ -192,6 +191,30 @@ func TestBorderUtilities(t *testing.T) {
 		})
 	}
 
+	radiusTests := []struct {
+		name     string
+		fn       func(css.Radius) css.Class
+		value    css.Radius
+		expected string
+	}{
+		{"rounded", css.Rounded, css.Radius4(), "rounded-4"},
+		{"rounded-t", css.RoundedT, css.Radius2(), "rounded-t-2"},
+		{"rounded-r", css.RoundedR, css.Radius6(), "rounded-r-6"},
+		{"rounded-b", css.RoundedB, css.Radius8(), "rounded-b-8"},
+		{"rounded-l", css.RoundedL, css.Radius1(), "rounded-l-1"},
+		{"rounded-tl", css.RoundedTl, css.Radius3(), "rounded-tl-3"},
+		{"rounded-tr", css.RoundedTr, css.Radius12(), "rounded-tr-12"},
+		{"rounded-br", css.RoundedBr, css.Radius16(), "rounded-br-16"},
+		{"rounded-bl", css.RoundedBl, css.Radius0(), "rounded-bl-0"},
+	}
+
+	for _, tt := range radiusTests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.fn(tt.value)
+			assert.Equal(t, tt.expected, string(result))
+		})
+	}
+
 	t.Run("rounded-full", func(t *testing.T) {
 		assert.Equal(t, "rounded-full", string(css.RoundedFull()))
 	})
@@ -228,9 +251,9 @@ func TestMultipleClasses(t *testing.T) {
 		css.Flex(),
 		css.ItemsCenter(),
 		css.JustifyBetween(),
-		css.P(4),
-		css.BgBlue(500),
-		css.TextBlue(100),
+		css.P(css.Spacing4()),
+		css.BgBlue(css.Shade500()),
+		css.TextBlue(css.Shade100()),
 	}
 
 	var classNames []string
@@ -251,10 +274,10 @@ func TestClassTracking(t *testing.T) {
 	assert.Empty(t, css.GetUsedClasses())
 	
 	// Use some classes
-	css.P(4)
-	css.BgRed(500)
+	css.P(css.Spacing4())
+	css.BgRed(css.Shade500())
 	css.Flex()
-	css.Rounded(8)
+	css.Rounded(css.Radius8())
 	
 	// Should now have tracked classes
 	usedClasses := css.GetUsedClasses()
@@ -275,8 +298,8 @@ func TestClassTracking(t *testing.T) {
 
 func TestResetTracking(t *testing.T) {
 	// Use some classes
-	css.P(2)
-	css.BgBlue(300)
+	css.P(css.Spacing2())
+	css.BgBlue(css.Shade300())
 	
 	// Should have tracked classes
 	assert.NotEmpty(t, css.GetUsedClasses())
@@ -288,15 +311,23 @@ func TestResetTracking(t *testing.T) {
 	assert.Empty(t, css.GetUsedClasses())
 }
 
+func TestSetPackageTracking(t *testing.T) {
+	css.SetPackageTracking(false)
+	defer css.SetPackageTracking(true)
+
+	css.P(css.Spacing4())
+	assert.Empty(t, css.GetUsedClasses())
+}
+
 func TestGenerateMinimalCSS(t *testing.T) {
 	// Reset tracking
 	css.ResetTracking()
 	
 	// Use only a few specific classes
-	css.P(4)
-	css.BgGreen(100)
-	css.TextGreen(800)
-	css.Rounded(4)
+	css.P(css.Spacing4())
+	css.BgGreen(css.Shade100())
+	css.TextGreen(css.Shade800())
+	css.Rounded(css.Radius4())
 	
 	// Generate minimal CSS
 	stylesheet := css.GenerateMinimalCSS()
@@ -327,4 +358,310 @@ func TestGenerateMinimalCSS(t *testing.T) {
 	for _, rule := range unexpectedRules {
 		assert.False(t, strings.Contains(cssContent, rule), "Expected minimal CSS NOT to contain %s", rule)
 	}
-}
\ No newline at end of file
+}
+
+func TestTracker(t *testing.T) {
+	tracker := css.NewTracker()
+	assert.Empty(t, tracker.Classes())
+
+	tracker.Track(css.P(css.Spacing4()), css.Flex(), css.P(css.Spacing4()), "")
+	assert.Equal(t, []string{"flex", "p-4"}, tracker.Classes())
+	assert.Equal(t, 2, tracker.Len())
+
+	cssContent := tracker.GenerateMinimalCSS().Generate()
+	assert.Contains(t, cssContent, ".p-4")
+	assert.Contains(t, cssContent, ".flex")
+	assert.NotContains(t, cssContent, ".p-8")
+
+	tracker.Reset()
+	assert.Empty(t, tracker.Classes())
+}
+
+func TestTrackersAreIndependent(t *testing.T) {
+	var wg sync.WaitGroup
+	spacing := []css.Spacing{css.Spacing0(), css.Spacing1(), css.Spacing2(), css.Spacing3(), css.Spacing4(), css.Spacing5(), css.Spacing6(), css.Spacing7()}
+	trackers := make([]*css.Tracker, len(spacing))
+	for i := range trackers {
+		trackers[i] = css.NewTracker()
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				trackers[i].Track(css.P(spacing[i]))
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	for i, tracker := range trackers {
+		assert.Equal(t, []string{fmt.Sprintf("p-%d", i)}, tracker.Classes())
+	}
+}
+
+func TestTrackerContext(t *testing.T) {
+	assert.Nil(t, css.TrackerFromContext(context.Background()))
+
+	tracker := css.NewTracker()
+	ctx := css.WithTracker(context.Background(), tracker)
+	assert.Same(t, tracker, css.TrackerFromContext(ctx))
+}
+
+func TestStateVariants(t *testing.T) {
+	assert.Equal(t, "hover:bg-blue-600", string(css.Hover(css.BgBlue(css.Shade600()))))
+	assert.Equal(t, "focus:hover:p-4", string(css.Focus(css.Hover(css.P(css.Spacing4())))))
+	assert.Equal(t, "disabled:opacity-50", string(css.Disabled(css.Opacity(css.Opacity50()))))
+	assert.Equal(t, "focus-visible:flex", string(css.FocusVisible(css.Flex())))
+	assert.Equal(t, "visited:text-purple-600", string(css.Variant("visited", css.TextPurple(css.Shade600()))))
+
+	tracker := css.NewTracker()
+	tracker.Track(css.Hover(css.BgBlue(css.Shade600())))
+	assert.Contains(t, tracker.GenerateMinimalCSS().Generate(), `.hover\:bg-blue-600:hover {`)
+}
+
+func TestBreakpointVariants(t *testing.T) {
+	assert.Equal(t, "md:grid", string(css.Md(css.Grid())))
+	assert.Equal(t, "sm:p-4", string(css.Sm(css.P(css.Spacing4()))))
+	assert.Equal(t, "lg:hover:bg-blue-600", string(css.Lg(css.Hover(css.BgBlue(css.Shade600())))))
+	assert.Equal(t, "2xl:hidden", string(css.Xl2(css.Hidden())))
+
+	tracker := css.NewTracker()
+	tracker.Track(css.Md(css.Grid()))
+	assert.Contains(t, tracker.GenerateMinimalCSS().Generate(), "@media (min-width: 768px) {\n  .md\\:grid { display: grid }\n}")
+}
+
+func TestDarkVariant(t *testing.T) {
+	assert.Equal(t, "dark:bg-gray-900", string(css.Dark(css.BgGray(css.Shade900()))))
+	assert.Equal(t, "md:dark:text-gray-100", string(css.Md(css.Dark(css.TextGray(css.Shade100())))))
+
+	assert.NoError(t, css.SetDarkMode(css.DarkModeSelector, ".dark"))
+	defer css.SetDarkMode(css.DarkModeMedia, "")
+
+	tracker := css.NewTracker()
+	tracker.Track(css.Dark(css.BgGray(css.Shade900())))
+	assert.Contains(t, tracker.GenerateMinimalCSS().Generate(), `.dark .dark\:bg-gray-900 { background-color: #111827 }`)
+}
+
+func TestArbitraryValues(t *testing.T) {
+	assert.Equal(t, "w-[37px]", string(css.Arb("w", "37px")))
+	assert.Equal(t, "bg-[#1da1f2]", string(css.BgArb("#1da1f2")))
+	assert.Equal(t, "md:grid-cols-[200px_1fr]", string(css.Md(css.GridColsArb("200px_1fr"))))
+
+	for _, value := range []string{"1px;color:red", "1px}", "1px 2px", "", "1px/*", "url('x)"} {
+		_, err := css.ArbitraryClass("w", value)
+		assert.Error(t, err, "value %q", value)
+	}
+	_, err := css.ArbitraryClass("not-a-utility", "1px")
+	assert.Error(t, err)
+	assert.Panics(t, func() { css.WArb("1px;color:red") })
+	assert.Panics(t, func() { css.BgArb("url('x)") })
+	assert.Equal(t, css.Class("bg-[url('a.png')]"), css.BgArb("url('a.png')"))
+
+	tracker := css.NewTracker()
+	tracker.Track(css.WArb("37px"), css.Md(css.GridColsArb("200px_1fr")))
+	generated := tracker.GenerateMinimalCSS().Generate()
+	assert.Contains(t, generated, `.w-\[37px\] { width: 37px }`)
+	assert.Contains(t, generated, `.md\:grid-cols-\[200px_1fr\] { grid-template-columns: 200px 1fr }`)
+}
+
+// offScaleValues returns values a theme adds to the spacing, shade and
+// opacity scales, built while the theme is active and used after it is
+// reset, so they have no rules
+func offScaleValues(t *testing.T) (css.Spacing, css.Shade, css.OpacityLevel) {
+	t.Helper()
+	theme := "extend:\n  spacing.scale: [13]\n  colors:\n    blue:\n      550: \"#2f6af0\"\n  effects.opacity.values:\n    - name: \"33\"\n      value: \"0.33\"\n"
+	if !assert.NoError(t, css.SetTheme([]byte(theme))) {
+		t.FailNow()
+	}
+	defer css.ResetTheme()
+	return css.ThemeValue[css.Spacing]("13"), css.ThemeValue[css.Shade]("550"), css.ThemeValue[css.OpacityLevel]("33")
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, css.Validate(css.P(css.Spacing4())))
+	assert.NoError(t, css.Validate(css.Md(css.Hover(css.BgBlue(css.Shade600())))))
+	assert.NoError(t, css.Validate(css.WArb("37px")))
+
+	// Values of a theme that is not active have no rule
+	spacing13, shade550, _ := offScaleValues(t)
+	err := css.Validate(css.P(spacing13))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `"p-13"`)
+		assert.Contains(t, err.Error(), "12, 14")
+	}
+	assert.Error(t, css.Validate(css.BgBlue(shade550)))
+	assert.Error(t, css.Validate(css.Variant("nope", css.P(css.Spacing4()))))
+	assert.Error(t, css.Validate("w-[1px;color:red]"))
+}
+
+func TestStrictMode(t *testing.T) {
+	spacing13, shade550, _ := offScaleValues(t)
+	assert.NotPanics(t, func() { css.P(spacing13) })
+
+	css.SetStrict(true)
+	defer css.SetStrict(false)
+
+	assert.True(t, css.Strict())
+	assert.NotPanics(t, func() { css.P(css.Spacing4()) })
+	assert.NotPanics(t, func() { css.Hover(css.BgBlue(css.Shade600())) })
+	assert.PanicsWithValue(t,
+		`css: unknown utility "bg-blue-550"; valid values for bg-blue- are 50, 100, 200, 300, 400, 500, 600, 700, 800, 900, 950`,
+		func() { css.BgBlue(shade550) })
+	assert.Panics(t, func() { css.Variant("nope", css.P(css.Spacing4())) })
+}
+
+func TestTypedValues(t *testing.T) {
+	assert.Equal(t, "p-4", string(css.P(css.Spacing4())))
+	assert.Equal(t, "bg-blue-500", string(css.BgBlue(css.Shade500())))
+	assert.Equal(t, "w-1/2", string(css.W(css.W1Of2())))
+	assert.Equal(t, "w-0.5", string(css.W(css.W0_5())))
+	assert.Equal(t, "max-w-screen-sm", string(css.MaxW(css.MaxWScreenSm())))
+	assert.Equal(t, "top-auto", string(css.Top(css.OffsetAuto())))
+	assert.Equal(t, "z-10", string(css.Z(css.Z10())))
+	assert.Equal(t, "opacity-50", string(css.Opacity(css.Opacity50())))
+	assert.Equal(t, "shadow-lg", string(css.Shadow(css.ShadowLg())))
+	assert.Equal(t, "rounded-t-2", string(css.RoundedT(css.Radius2())))
+	assert.Equal(t, "border-4", string(css.Border(css.BorderWidth4())))
+
+	// Every generated value names a value that has a rule
+	for _, class := range []css.Class{
+		css.W(css.WScreen()), css.W(css.W11Of12()), css.H(css.H5Of6()), css.MinW(css.MinWFit()),
+		css.MaxH(css.MaxHNone()), css.MinH(css.MinHFull()), css.Inset(css.Offset1Of2()),
+	} {
+		assert.NoError(t, css.Validate(class))
+	}
+}
+
+func TestThemeValue(t *testing.T) {
+	assert.PanicsWithValue(t,
+		"css: unknown Spacing value \"13\"; valid values are 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 14, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 72, 80, 96",
+		func() { css.ThemeValue[css.Spacing]("13") })
+	assert.PanicsWithValue(t, "css: empty Spacing value", func() { css.ThemeValue[css.Spacing]("") })
+
+	assert.NoError(t, css.SetTheme([]byte("extend:\n  spacing.scale: [13]\n")))
+	defer css.ResetTheme()
+	assert.Equal(t, css.Class("p-13"), css.P(css.ThemeValue[css.Spacing]("13")))
+}
+
+func TestZeroValues(t *testing.T) {
+	assert.PanicsWithValue(t, "css: zero Spacing value", func() { css.P(css.Spacing{}) })
+	assert.PanicsWithValue(t, "css: zero ShadowSize value", func() { css.Shadow(css.ShadowSize{}) })
+	assert.PanicsWithValue(t, "css: zero OpacityLevel value", func() { css.WithOpacity(css.BgRed(css.Shade500()), css.OpacityLevel{}) })
+}
+
+func TestValuesOffTheScaleDoNotCompile(t *testing.T) {
+	fset := token.NewFileSet()
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	check := func(expr string) error {
+		src := "package p\n\nimport \"github.com/computesdk/zforge/css\"\n\nvar _ = " + expr + "\n"
+		file, err := parser.ParseFile(fset, "p.go", src, 0)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		_, err = conf.Check("p", fset, []*ast.File{file}, nil)
+		return err
+	}
+
+	assert.NoError(t, check(`css.P(css.Spacing4())`))
+	for _, expr := range []string{`css.P(13)`, `css.W("1/7")`, `css.BgBlue(550)`, `css.Shadow("huge")`, `css.WithOpacity(css.Flex(), 33)`, `func() { css.Spacing4 = css.Spacing8 }`} {
+		assert.Error(t, check(expr), expr)
+	}
+}
+
+func TestSetTheme(t *testing.T) {
+	theme := []byte("extend:\n  colors:\n    brand:\n      500: \"#ff0066\"\n")
+	assert.NoError(t, css.SetTheme(theme))
+	defer css.ResetTheme()
+
+	assert.NoError(t, css.Validate("bg-brand-500"))
+	tracker := css.NewTracker()
+	tracker.Track(css.Register("bg-brand-500"))
+	assert.Contains(t, tracker.GenerateMinimalCSS().Generate(), ".bg-brand-500 { background-color: #ff0066 }")
+
+	// A rejected theme keeps the current one
+	assert.Error(t, css.SetTheme([]byte("extend:\n  colors: [red]\n")))
+	assert.NoError(t, css.Validate("bg-brand-500"))
+
+	css.ResetTheme()
+	assert.Error(t, css.Validate("bg-brand-500"))
+}
+
+func TestSetCustomProperties(t *testing.T) {
+	css.SetCustomProperties(true)
+	defer css.SetCustomProperties(false)
+
+	tracker := css.NewTracker()
+	tracker.Track(css.BgRed(css.Shade500()), css.P(css.Spacing4()))
+	output := tracker.GenerateMinimalCSS().Generate()
+	assert.Contains(t, output, ":root { --color-red-500: #ef4444; --spacing-4: 1.00rem }")
+	assert.Contains(t, output, ".bg-red-500 { background-color: var(--color-red-500) }")
+	assert.Contains(t, output, ".p-4 { padding: var(--spacing-4) }")
+}
+
+func TestWithOpacity(t *testing.T) {
+	class := css.WithOpacity(css.BgRed(css.Shade500()), css.Opacity50())
+	assert.Equal(t, css.Class("bg-red-500/50"), class)
+	assert.NoError(t, css.Validate(class))
+	_, _, opacity33 := offScaleValues(t)
+	assert.Error(t, css.Validate(css.WithOpacity(css.BgRed(css.Shade500()), opacity33)))
+
+	tracker := css.NewTracker()
+	tracker.Track(class, css.Hover(css.WithOpacity(css.TextBlue(css.Shade500()), css.Opacity75())))
+	output := tracker.GenerateMinimalCSS().Generate()
+	assert.Contains(t, output, `.bg-red-500\/50 { background-color: rgb(239 68 68 / 0.5) }`)
+	assert.Contains(t, output, `.hover\:text-blue-500\/75:hover { color: rgb(59 130 246 / 0.75) }`)
+}
+
+func TestUtilityFunctions(t *testing.T) {
+	tests := []struct {
+		class    css.Class
+		expected string
+	}{
+		{css.BgGradientToR(), "bg-gradient-to-r"},
+		{css.FromIndigo(css.Shade500()), "from-indigo-500"},
+		{css.ViaPurple(css.Shade500()), "via-purple-500"},
+		{css.ToPink(css.Shade500()), "to-pink-500"},
+		{css.Transition(), "transition"},
+		{css.Duration(css.Ms200()), "duration-200"},
+		{css.Delay(css.Ms100()), "delay-100"},
+		{css.EaseInOut(), "ease-in-out"},
+		{css.AnimateSpin(), "animate-spin"},
+		{css.Scale(css.Scale110()), "scale-110"},
+		{css.ScaleX(css.Scale50()), "scale-x-50"},
+		{css.Rotate(css.Rotate45()), "rotate-45"},
+		{css.NegRotate(css.Rotate90()), "-rotate-90"},
+		{css.SkewY(css.Skew3()), "skew-y-3"},
+		{css.TranslateX(css.Translate4()), "translate-x-4"},
+		{css.NegTranslateX(css.Translate1Of2()), "-translate-x-1/2"},
+		{css.TranslateY(css.TranslateFull()), "translate-y-full"},
+		{css.OriginCenter(), "origin-center"},
+		{css.Blur(), "blur"},
+		{css.Blur(css.BlurLg()), "blur-lg"},
+		{css.Brightness(css.Brightness110()), "brightness-110"},
+		{css.Grayscale(css.Grayscale0()), "grayscale-0"},
+		{css.DropShadow(css.DropShadowMd()), "drop-shadow-md"},
+		{css.BackdropBlur(css.BlurSm()), "backdrop-blur-sm"},
+		{css.BorderTRed(css.Shade500()), "border-t-red-500"},
+		{css.DivideY(), "divide-y"},
+		{css.DivideX(css.BorderWidth2()), "divide-x-2"},
+		{css.Ring(), "ring"},
+		{css.RingBlue(css.Shade500()), "ring-blue-500"},
+		{css.RingOffset(css.RingWidth2()), "ring-offset-2"},
+		{css.OutlineOffset(css.OutlineSize4()), "outline-offset-4"},
+		{css.Container(), "container"},
+		{css.GridCols(css.Tracks3()), "grid-cols-3"},
+		{css.Gap(css.Spacing4()), "gap-4"},
+		{css.FlexWrap(), "flex-wrap"},
+		{css.Underline(), "underline"},
+		{css.NegTop(css.Offset1()), "-top-1"},
+		{css.NegZ(css.Z10()), "-z-10"},
+		{css.BgWhite(), "bg-white"},
+		{css.WithOpacity(css.BgBlack(), css.Opacity50()), "bg-black/50"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, string(tt.class))
+			assert.NoError(t, css.Validate(tt.class))
+		})
+	}
+}
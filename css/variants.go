@@ -0,0 +1,40 @@
+package css
+
+import "github.com/computesdk/zforge/css/internal"
+
+// Variant prefixes a utility with a state or breakpoint variant, so
+// Variant("hover", BgBlue(600)) yields "hover:bg-blue-600" and
+// Variant("md", Grid()) yields "md:grid". Variants nest: the minimal CSS for
+// "md:hover:p-4" applies the pseudo-class inside the md media query. The
+// generated wrappers like Hover and Md call Variant for each state and
+// breakpoint in the config.
+func Variant(name string, class Class) Class {
+	className := name + ":" + string(class)
+	trackClass(className)
+	return Class(className)
+}
+
+// Dark applies a utility in dark mode, so Dark(BgGray(900)) yields
+// "dark:bg-gray-900". SetDarkMode selects how dark mode is detected.
+func Dark(class Class) Class {
+	return Variant("dark", class)
+}
+
+// DarkModeStrategy selects how Dark utilities are applied
+type DarkModeStrategy string
+
+const (
+	// DarkModeMedia applies Dark utilities when the user's system prefers a
+	// dark color scheme
+	DarkModeMedia DarkModeStrategy = internal.DarkModeMedia
+	// DarkModeSelector applies Dark utilities under an ancestor matching a
+	// selector such as ".dark" or "[data-theme=dark]"
+	DarkModeSelector DarkModeStrategy = internal.DarkModeSelector
+)
+
+// SetDarkMode overrides the dark_mode setting from the config for all
+// stylesheets generated afterwards. The selector is only used by
+// DarkModeSelector. It is meant to be called once at startup.
+func SetDarkMode(strategy DarkModeStrategy, selector string) error {
+	return internal.SetDarkMode(internal.DarkMode{Strategy: string(strategy), Selector: selector})
+}
@@ -0,0 +1,57 @@
+package html
+
+// Attribute is a single HTML attribute
+type Attribute struct {
+	Key   string
+	Value string
+}
+
+// Attributes is an ordered list of attributes. Attributes are rendered in the
+// order they were first set, so output is stable across runs.
+type Attributes []Attribute
+
+// Get returns the value of the attribute and whether it is set
+func (a Attributes) Get(key string) (string, bool) {
+	if i := a.index(key); i >= 0 {
+		return a[i].Value, true
+	}
+	return "", false
+}
+
+// Has reports whether the attribute is set
+func (a Attributes) Has(key string) bool {
+	return a.index(key) >= 0
+}
+
+// Set sets the value of the attribute. An existing attribute keeps its
+// position; a new attribute is appended.
+func (a *Attributes) Set(key, value string) {
+	if i := a.index(key); i >= 0 {
+		(*a)[i].Value = value
+		return
+	}
+	*a = append(*a, Attribute{Key: key, Value: value})
+}
+
+// Delete removes the attribute if it is set
+func (a *Attributes) Delete(key string) {
+	i := a.index(key)
+	if i < 0 {
+		return
+	}
+	// Build a new slice rather than shifting in place, since copies of an
+	// element added as a child share the backing array
+	attrs := make(Attributes, 0, len(*a)-1)
+	attrs = append(attrs, (*a)[:i]...)
+	*a = append(attrs, (*a)[i+1:]...)
+}
+
+// index returns the position of the attribute, or -1 if it is not set
+func (a Attributes) index(key string) int {
+	for i := range a {
+		if a[i].Key == key {
+			return i
+		}
+	}
+	return -1
+}
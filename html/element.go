@@ -1,7 +1,7 @@
 package html
 
 import (
-	"fmt"
+	"io"
 	"slices"
 	"strings"
 	
@@ -11,8 +11,15 @@ import (
 type Element struct {
 	Tag        string
 	Content    string
-	Attributes map[string]string
+	Attributes Attributes
 	Children   []Element
+
+	// raw marks Content as trusted markup that is written without escaping
+	raw bool
+	// classes holds the utilities passed to Class, collected at render time
+	// while the class attribute is still classAttr
+	classes   []css.Class
+	classAttr string
 }
 
 // New creates a new element with the specified tag
@@ -22,129 +29,83 @@ func New(tag string) *Element {
 
 // Class sets the class attribute and returns the element for chaining
 func (e *Element) Class(classes ...css.Class) *Element {
-	if e.Attributes == nil {
-		e.Attributes = make(map[string]string)
-	}
-	
 	classStrings := make([]string, len(classes))
 	for i, class := range classes {
 		classStrings[i] = class.String()
 	}
 	
-	e.Attributes["class"] = strings.Join(classStrings, " ")
+	e.setClasses(strings.Join(classStrings, " "), slices.Clone(classes))
 	return e
 }
 
 // ID sets the id attribute and returns the element for chaining
 func (e *Element) ID(id string) *Element {
-	if e.Attributes == nil {
-		e.Attributes = make(map[string]string)
-	}
-	e.Attributes["id"] = id
+	e.Attributes.Set("id", id)
 	return e
 }
 
-// Attr sets a custom attribute and returns the element for chaining
+// Attr sets a custom attribute and returns the element for chaining. Setting
+// the class attribute replaces the classes passed to Class.
 func (e *Element) Attr(key, value string) *Element {
-	if e.Attributes == nil {
-		e.Attributes = make(map[string]string)
+	if key == "class" {
+		var classes []css.Class
+		for _, token := range strings.Fields(value) {
+			classes = append(classes, css.Class(token))
+		}
+		e.setClasses(value, classes)
+		return e
 	}
-	e.Attributes[key] = value
+	e.Attributes.Set(key, value)
 	return e
 }
 
+// setClasses sets the class attribute and the classes tracked for it
+func (e *Element) setClasses(value string, classes []css.Class) {
+	e.Attributes.Set("class", value)
+	e.classes = classes
+	e.classAttr = value
+}
+
 // AddChildren adds children to the element and returns the element for chaining
 func (e *Element) AddChildren(children ...*Element) *Element {
 	for _, child := range children {
 		if child != nil {
-			e.Children = append(e.Children, *child)
+			c := *child
+			// Clip so attributes added to the copy never overwrite
+			// attributes later added to the original
+			c.Attributes = slices.Clip(c.Attributes)
+			e.Children = append(e.Children, c)
 		}
 	}
 	return e
 }
 
-// SetContent sets the content and returns the element for chaining
+// SetContent sets the text content and returns the element for chaining.
+// The content is escaped when rendered; use Raw for trusted markup.
 func (e *Element) SetContent(content string) *Element {
 	e.Content = content
+	e.raw = false
 	return e
 }
 
-// Render processes the element tree and returns the final HTML string
+// Render processes the element tree and returns the final HTML string.
+// It uses the default RenderOptions and never modifies the tree.
 func (e *Element) Render() string {
-	// Inject minimal CSS if a head element exists and CSS classes were used
-	head := e.findHead()
-	if head != nil {
-		usedClasses := css.GetUsedClasses()
-		if len(usedClasses) > 0 {
-			stylesheet := css.GenerateMinimalCSS()
-			head.Children = append(head.Children, *Style(stylesheet.Generate()))
-		}
-	}
-	
-	// Generate and return HTML string
-	return e.toHTML()
-}
-
-
-// findHead recursively searches for the first head element in the document tree
-func (e *Element) findHead() *Element {
-	// Check if this element is a head
-	if e.Tag == "head" {
-		return e
-	}
-	
-	// Recursively search children
-	for i := range e.Children {
-		if found := e.Children[i].findHead(); found != nil {
-			return found
-		}
-	}
-	
-	return nil
+	return e.RenderWith(RenderOptions{})
 }
 
-// toHTML converts the element and its children to an HTML string
-func (e *Element) toHTML() string {
-	if e == nil {
-		return ""
-	}
-	
-	// Handle text-only elements (no tag)
-	if e.Tag == "" {
-		return e.Content
-	}
-	
-	html := fmt.Sprintf("<%s", e.Tag)
-
-	for key, value := range e.Attributes {
-		html += fmt.Sprintf(` %s="%s"`, key, value)
-	}
-
-	if isSelfClosing(e.Tag) {
-		html += " />"
-		return html
-	}
-
-	html += ">"
-
-	if e.Content != "" {
-		html += e.Content
-	}
-
-	for _, child := range e.Children {
-		html += child.toHTML()
-	}
-
-	html += fmt.Sprintf("</%s>", e.Tag)
-	return html
+// WriteTo streams the rendered element tree to w through a buffered writer.
+// It produces the same output as Render and implements io.WriterTo.
+func (e *Element) WriteTo(w io.Writer) (int64, error) {
+	return e.WriteToWith(w, RenderOptions{})
 }
 
 // isSelfClosing checks if an HTML tag is self-closing
 func isSelfClosing(tag string) bool {
-	selfClosingTags := []string{
-		"area", "base", "br", "col", "embed", "hr", "img", "input",
-		"link", "meta", "param", "source", "track", "wbr",
-	}
-	
 	return slices.Contains(selfClosingTags, strings.ToLower(tag))
+}
+
+var selfClosingTags = []string{
+	"area", "base", "br", "col", "embed", "hr", "img", "input",
+	"link", "meta", "param", "source", "track", "wbr",
 }
\ No newline at end of file
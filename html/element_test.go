@@ -1,6 +1,10 @@
 package html_test
 
 import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/computesdk/zforge/css"
@@ -26,12 +30,12 @@ func TestWithAttributes(t *testing.T) {
 	css.ResetTracking()
 	
 	// Test div with attributes using fluent API
-	div := html.Div().SetContent("Styled content").Class(css.P(4), css.BgBlue(100)).ID("main")
+	div := html.Div().SetContent("Styled content").Class(css.P(css.Spacing4()), css.BgBlue(css.Shade100())).ID("main")
 	result := div.Render()
 
-	// Should contain both attributes (order may vary)
-	if !contains(result, `class="p-4 bg-blue-100"`) || !contains(result, `id="main"`) {
-		t.Errorf("Expected attributes not found in: %s", result)
+	expected := `<div class="p-4 bg-blue-100" id="main">Styled content</div>`
+	if result != expected {
+		t.Errorf("Expected %s, got %s", expected, result)
 	}
 }
 
@@ -43,12 +47,9 @@ func TestSelfClosingTags(t *testing.T) {
 	img := html.Img("/path/to/image.jpg").Attr("alt", "Test image")
 	result := img.Render()
 
-	// Check that it's a self-closing img tag with the correct attributes
-	if !contains(result, `<img`) || !contains(result, `/>`) {
-		t.Errorf("Expected self-closing img tag, got: %s", result)
-	}
-	if !contains(result, `src="/path/to/image.jpg"`) || !contains(result, `alt="Test image"`) {
-		t.Errorf("Expected attributes not found in: %s", result)
+	expected := `<img src="/path/to/image.jpg" alt="Test image" />`
+	if result != expected {
+		t.Errorf("Expected %s, got %s", expected, result)
 	}
 }
 
@@ -110,21 +111,73 @@ func TestMethodChaining(t *testing.T) {
 
 	result := div.Render()
 
-	// Check all attributes are present
-	if !contains(result, `class="container"`) {
-		t.Errorf("Expected class attribute not found in: %s", result)
+	expected := `<div class="container" id="main" data-test="value"><h1 class="header">Title</h1><p id="content">Content</p></div>`
+	if result != expected {
+		t.Errorf("Expected %s, got %s", expected, result)
+	}
+}
+
+func TestAttributeOrder(t *testing.T) {
+	css.ResetTracking()
+
+	// Attributes render in the order they were first set; updating an
+	// attribute keeps its position
+	input := html.Input("text").
+		Attr("name", "q").
+		ID("search").
+		Attr("placeholder", "Search").
+		Attr("name", "query")
+
+	expected := `<input type="text" name="query" id="search" placeholder="Search" />`
+	for i := 0; i < 20; i++ {
+		if result := input.Render(); result != expected {
+			t.Fatalf("Expected %s, got %s", expected, result)
+		}
+	}
+}
+
+func TestAttributesMethods(t *testing.T) {
+	var attrs html.Attributes
+
+	attrs.Set("id", "main")
+	attrs.Set("class", "p-4")
+	attrs.Set("data-x", "1")
+
+	if value, ok := attrs.Get("class"); !ok || value != "p-4" {
+		t.Errorf("Expected class p-4, got %q (set: %v)", value, ok)
 	}
-	if !contains(result, `id="main"`) {
-		t.Errorf("Expected id attribute not found in: %s", result)
+	if _, ok := attrs.Get("missing"); ok {
+		t.Error("Expected missing attribute not to be set")
 	}
-	if !contains(result, `data-test="value"`) {
-		t.Errorf("Expected data-test attribute not found in: %s", result)
+
+	attrs.Set("id", "other")
+	attrs.Delete("class")
+	attrs.Delete("missing")
+
+	if attrs.Has("class") {
+		t.Error("Expected class to be deleted")
+	}
+	expected := html.Attributes{{Key: "id", Value: "other"}, {Key: "data-x", Value: "1"}}
+	if len(attrs) != len(expected) || attrs[0] != expected[0] || attrs[1] != expected[1] {
+		t.Errorf("Expected %v, got %v", expected, attrs)
 	}
-	if !contains(result, `<h1 class="header">Title</h1>`) {
-		t.Errorf("Expected h1 with class not found in: %s", result)
+}
+
+func TestChildAttributesAreIndependent(t *testing.T) {
+	css.ResetTracking()
+
+	child := html.Span("x").ID("a")
+	parent := html.Div(child)
+
+	// Attributes added after the copy is taken must not clobber each other
+	child.Attr("data-original", "1")
+	parent.Children[0].Attributes.Set("data-copy", "2")
+
+	if got := child.Render(); got != `<span id="a" data-original="1">x</span>` {
+		t.Errorf("Unexpected original: %s", got)
 	}
-	if !contains(result, `<p id="content">Content</p>`) {
-		t.Errorf("Expected p with id not found in: %s", result)
+	if got := parent.Render(); got != `<div><span id="a" data-copy="2">x</span></div>` {
+		t.Errorf("Unexpected copy: %s", got)
 	}
 }
 
@@ -135,7 +188,7 @@ func TestCSSIntegration(t *testing.T) {
 	// Test CSS utility integration
 	div := html.Div().
 		SetContent("Styled with CSS utilities").
-		Class(css.P(4), css.M(2), css.BgGray(100), css.TextGray(800), css.Rounded(8))
+		Class(css.P(css.Spacing4()), css.M(css.Spacing2()), css.BgGray(css.Shade100()), css.TextGray(css.Shade800()), css.Rounded(css.Radius8()))
 	
 	result := div.Render()
 	
@@ -155,7 +208,7 @@ func TestRenderWithCSSInjection(t *testing.T) {
 			html.Title("CSS Test"),
 		),
 		html.Body(
-			html.Div().SetContent("Test content").Class(css.P(4), css.BgRed(100)),
+			html.Div().SetContent("Test content").Class(css.P(css.Spacing4()), css.BgRed(css.Shade100())),
 		),
 	)
 	
@@ -173,6 +226,375 @@ func TestRenderWithCSSInjection(t *testing.T) {
 	}
 }
 
+func TestRenderIsIdempotent(t *testing.T) {
+	css.ResetTracking()
+
+	layout := html.Html(
+		html.Head(html.Title("Cached")),
+		html.Body(html.Div().Class(css.P(css.Spacing4()))),
+	)
+
+	first := layout.Render()
+	second := layout.Render()
+
+	if first != second {
+		t.Errorf("Expected identical renders, got:\n%s\n%s", first, second)
+	}
+	if count := strings.Count(second, "<style>"); count != 1 {
+		t.Errorf("Expected one style element, got %d in %s", count, second)
+	}
+	if len(layout.Children[0].Children) != 1 {
+		t.Errorf("Expected Render not to modify the head, got %d children", len(layout.Children[0].Children))
+	}
+}
+
+func TestRenderOptionsCSSPlacement(t *testing.T) {
+	css.ResetTracking()
+
+	document := html.Html(
+		html.Head(html.Title("T")),
+		html.Body(html.Div().Class(css.P(css.Spacing4()))),
+	)
+
+	result := document.RenderWith(html.RenderOptions{CSS: html.CSSLink, StylesheetURL: "/static/app.css"})
+	expected := `<html><head><title>T</title><link rel="stylesheet" href="/static/app.css" /></head><body><div class="p-4"></div></body></html>`
+	if result != expected {
+		t.Errorf("Expected %s, got %s", expected, result)
+	}
+
+	// Without a URL there is nothing to link
+	result = document.RenderWith(html.RenderOptions{CSS: html.CSSLink})
+	expected = `<html><head><title>T</title></head><body><div class="p-4"></div></body></html>`
+	if result != expected {
+		t.Errorf("Expected %s, got %s", expected, result)
+	}
+
+	result = document.RenderWith(html.RenderOptions{CSS: html.CSSPlaceholder})
+	expected = `<html><head><title>T</title>` + html.DefaultCSSPlaceholder + `</head><body><div class="p-4"></div></body></html>`
+	if result != expected {
+		t.Errorf("Expected %s, got %s", expected, result)
+	}
+
+	result = document.RenderWith(html.RenderOptions{CSS: html.CSSNone})
+	expected = `<html><head><title>T</title></head><body><div class="p-4"></div></body></html>`
+	if result != expected {
+		t.Errorf("Expected %s, got %s", expected, result)
+	}
+}
+
+func TestRenderOptionsMissingHead(t *testing.T) {
+	css.ResetTracking()
+
+	document := html.Html(html.Body(html.P("x")))
+	opts := html.RenderOptions{CSS: html.CSSPlaceholder, Placeholder: "<!--css-->"}
+
+	if result := document.RenderWith(opts); result != "<html><body><p>x</p></body></html>" {
+		t.Errorf("Expected stylesheet to be skipped, got %s", result)
+	}
+
+	opts.MissingHead = html.MissingHeadCreate
+	if result := document.RenderWith(opts); result != "<html><head><!--css--></head><body><p>x</p></body></html>" {
+		t.Errorf("Expected a created head, got %s", result)
+	}
+
+	opts.MissingHead = html.MissingHeadPrepend
+	if result := html.Div(html.P("x")).RenderWith(opts); result != "<!--css--><div><p>x</p></div>" {
+		t.Errorf("Expected stylesheet before the fragment, got %s", result)
+	}
+}
+
+func TestRenderTracksClassesInTree(t *testing.T) {
+	css.ResetTracking()
+
+	// A class constructed but never added to the tree ships no CSS
+	css.P(css.Spacing8())
+	document := html.Html(
+		html.Head(html.Title("T")),
+		html.Body(html.Div().Class(css.M(css.Spacing2()))),
+	)
+	result := document.Render()
+
+	if !contains(result, ".m-2") {
+		t.Errorf("Expected .m-2 CSS rule, got: %s", result)
+	}
+	if contains(result, ".p-8") {
+		t.Errorf("Expected no .p-8 CSS rule, got: %s", result)
+	}
+}
+
+func TestConcurrentRendersDoNotShareClasses(t *testing.T) {
+	var wg sync.WaitGroup
+	spacing := []css.Spacing{css.Spacing0(), css.Spacing1(), css.Spacing2(), css.Spacing3(), css.Spacing4(), css.Spacing5(), css.Spacing6(), css.Spacing7()}
+	results := make([]string, len(spacing))
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			document := html.Html(
+				html.Head(),
+				html.Body(html.Div().Class(css.P(spacing[i]))),
+			)
+			results[i] = document.Render()
+		}(i)
+	}
+	wg.Wait()
+
+	for i, result := range results {
+		for j := range results {
+			rule := fmt.Sprintf(".p-%d {", j)
+			if (i == j) != contains(result, rule) {
+				t.Errorf("Render %d: unexpected presence of %s in %s", i, rule, result)
+			}
+		}
+	}
+}
+
+func TestRenderWithSharedTracker(t *testing.T) {
+	tracker := css.NewTracker()
+
+	// Render a fragment first, then the layout that embeds it
+	fragment := html.Div().Class(css.Flex()).RenderWith(html.RenderOptions{CSS: html.CSSNone, Tracker: tracker})
+	layout := html.Html(html.Head(), html.Body(html.Raw(fragment)).Class(css.P(css.Spacing2())))
+	result := layout.RenderWith(html.RenderOptions{Tracker: tracker})
+
+	if !contains(result, ".flex") || !contains(result, ".p-2") {
+		t.Errorf("Expected CSS for fragment and layout, got: %s", result)
+	}
+	if !contains(result, `<div class="flex"></div>`) {
+		t.Errorf("Expected fragment markup, got: %s", result)
+	}
+}
+
+func TestRenderTracksReplacedClasses(t *testing.T) {
+	classes := []css.Class{css.P(css.Spacing4())}
+	kept := html.Div().Class(classes...)
+	classes[0] = css.P(css.Spacing8())
+	deleted := html.Div().Class(css.Flex())
+	deleted.Attributes.Delete("class")
+
+	document := html.Html(
+		html.Head(),
+		html.Body(
+			kept,
+			html.Div().Class(css.M(css.Spacing2())).Attr("class", "block"),
+			deleted,
+		),
+	)
+	result := document.Render()
+
+	for _, rule := range []string{".p-4 {", ".block {"} {
+		if !contains(result, rule) {
+			t.Errorf("Expected %s CSS rule, got: %s", rule, result)
+		}
+	}
+	// p-8 only changed the caller's slice, m-2 was replaced by Attr and flex
+	// was deleted, so none of them is in the output
+	for _, rule := range []string{".p-8", ".m-2", ".flex"} {
+		if contains(result, rule) {
+			t.Errorf("Expected no %s CSS rule, got: %s", rule, result)
+		}
+	}
+}
+
+func TestRenderTracksClassAttributes(t *testing.T) {
+	document := html.Html(
+		html.Head(),
+		html.Body(
+			html.Div().Attr("class", "flex  p-4"),
+			html.Div().Class(css.M(css.Spacing2())).Attr("class", "block"),
+		),
+	)
+	opts := html.RenderOptions{ClassTracking: html.TrackClassAttributes}
+	result := document.RenderWith(opts)
+
+	for _, rule := range []string{".flex {", ".p-4 {", ".block {"} {
+		if !contains(result, rule) {
+			t.Errorf("Expected %s CSS rule, got: %s", rule, result)
+		}
+	}
+	// m-2 was overwritten by the raw class attribute, so it is not in the output
+	if contains(result, ".m-2") {
+		t.Errorf("Expected no .m-2 CSS rule, got: %s", result)
+	}
+}
+
+func TestContentEscaping(t *testing.T) {
+	css.ResetTracking()
+
+	result := html.P(`<script>alert("x")</script> & more`).Render()
+	expected := `<p>&lt;script&gt;alert("x")&lt;/script&gt; &amp; more</p>`
+	if result != expected {
+		t.Errorf("Expected %s, got %s", expected, result)
+	}
+
+	result = html.Div(html.Text("a < b")).Render()
+	expected = "<div>a &lt; b</div>"
+	if result != expected {
+		t.Errorf("Expected %s, got %s", expected, result)
+	}
+}
+
+func TestAttributeEscaping(t *testing.T) {
+	css.ResetTracking()
+
+	result := html.Span("x").Attr("title", `"><img src=x onerror=alert(1)>`).Render()
+	expected := `<span title="&#34;&gt;&lt;img src=x onerror=alert(1)&gt;">x</span>`
+	if result != expected {
+		t.Errorf("Expected %s, got %s", expected, result)
+	}
+
+	// Attribute names that would break out of the tag are dropped
+	result = html.Span("x").Attr(`onclick="alert(1)"`, "v").Render()
+	if result != "<span>x</span>" {
+		t.Errorf("Expected invalid attribute name to be dropped, got %s", result)
+	}
+}
+
+func TestURLAttributeSanitizing(t *testing.T) {
+	css.ResetTracking()
+
+	tests := []struct {
+		href     string
+		expected string
+	}{
+		{"/relative/path?q=1", `href="/relative/path?q=1"`},
+		{"https://example.com/a?b=1&c=2", `href="https://example.com/a?b=1&amp;c=2"`},
+		{"mailto:team@example.com", `href="mailto:team@example.com"`},
+		{"javascript:alert(1)", `href="about:invalid#zforge-unsafe-url"`},
+		{" JaVa\tScRiPt:alert(1)", `href="about:invalid#zforge-unsafe-url"`},
+		{"data:text/html,<script>alert(1)</script>", `href="about:invalid#zforge-unsafe-url"`},
+		{"page#section:2", `href="page#section:2"`},
+	}
+
+	for _, tt := range tests {
+		result := html.A().Attr("href", tt.href).Render()
+		if !contains(result, tt.expected) {
+			t.Errorf("href %q: expected %s in %s", tt.href, tt.expected, result)
+		}
+	}
+}
+
+func TestScriptAndStyleEscaping(t *testing.T) {
+	css.ResetTracking()
+
+	// Script bodies are not entity-escaped, but cannot close the element early
+	result := html.Script(`if (a < b && c) { s = "</script><b>x</b>" }`).Render()
+	expected := `<script>if (a < b && c) { s = "<\/script><b>x</b>" }</script>`
+	if result != expected {
+		t.Errorf("Expected %s, got %s", expected, result)
+	}
+
+	result = html.Style(`.a > .b { content: "</STYLE>" }`).Render()
+	expected = `<style>.a > .b { content: "<\/STYLE>" }</style>`
+	if result != expected {
+		t.Errorf("Expected %s, got %s", expected, result)
+	}
+
+	// Text children inherit the escaping context of their parent
+	result = html.Script("").AddChildren(html.Text("x = 1 < 2")).Render()
+	expected = "<script>x = 1 < 2</script>"
+	if result != expected {
+		t.Errorf("Expected %s, got %s", expected, result)
+	}
+}
+
+func TestRawMarkup(t *testing.T) {
+	css.ResetTracking()
+
+	result := html.Div(html.Raw("<em>trusted</em>"), html.Text("<em>")).Render()
+	expected := "<div><em>trusted</em>&lt;em&gt;</div>"
+	if result != expected {
+		t.Errorf("Expected %s, got %s", expected, result)
+	}
+
+	// SetContent always stores text, even on a raw element
+	result = html.Raw("<b>").SetContent("<b>").Render()
+	if result != "&lt;b&gt;" {
+		t.Errorf("Expected escaped content, got %s", result)
+	}
+}
+
+func TestWriteTo(t *testing.T) {
+	css.ResetTracking()
+
+	table := wideTree(50)
+	expected := table.Render()
+
+	var b strings.Builder
+	n, err := table.WriteTo(&b)
+	if err != nil {
+		t.Fatalf("WriteTo returned error: %v", err)
+	}
+	if b.String() != expected {
+		t.Errorf("WriteTo output differs from Render:\n%s\n%s", b.String(), expected)
+	}
+	if n != int64(len(expected)) {
+		t.Errorf("Expected %d bytes written, got %d", len(expected), n)
+	}
+}
+
+// wideTree builds a report-style table with the given number of rows
+func wideTree(rows int) *html.Element {
+	body := html.Tbody()
+	for i := 0; i < rows; i++ {
+		body.AddChildren(html.Tr(
+			html.Td(fmt.Sprintf("row %d", i)),
+			html.Td("Widget & Co").Attr("data-id", fmt.Sprint(i)),
+			html.Td("42.00"),
+		))
+	}
+	return html.Table(html.Thead(html.Tr(html.Th("Name"), html.Th("Vendor"), html.Th("Price"))), body)
+}
+
+// deepTree builds a chain of nested divs with the given depth
+func deepTree(depth int) *html.Element {
+	node := html.Span("leaf")
+	for i := 0; i < depth; i++ {
+		node = html.Div(node).Attr("data-depth", fmt.Sprint(i))
+	}
+	return node
+}
+
+func BenchmarkRenderWide(b *testing.B) {
+	css.ResetTracking()
+	tree := wideTree(5000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = tree.Render()
+	}
+}
+
+func BenchmarkWriteToWide(b *testing.B) {
+	css.ResetTracking()
+	tree := wideTree(5000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tree.WriteTo(io.Discard)
+	}
+}
+
+func BenchmarkRenderDeep(b *testing.B) {
+	css.ResetTracking()
+	tree := deepTree(500)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = tree.Render()
+	}
+}
+
+func BenchmarkWriteToDeep(b *testing.B) {
+	css.ResetTracking()
+	tree := deepTree(500)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tree.WriteTo(io.Discard)
+	}
+}
+
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(s) > len(substr) &&
 		(s[:len(substr)] == substr || s[len(s)-len(substr):] == substr ||
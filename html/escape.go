@@ -0,0 +1,173 @@
+package html
+
+import (
+	"io"
+	"strings"
+)
+
+// htmlWriter is the destination the renderer streams markup into. Both
+// *bufio.Writer and *strings.Builder satisfy it.
+type htmlWriter interface {
+	io.Writer
+	io.StringWriter
+	io.ByteWriter
+}
+
+// contentContext describes how text inside an element must be escaped
+type contentContext int
+
+const (
+	// contextText is ordinary element content; markup characters are escaped
+	contextText contentContext = iota
+	// contextScript is the raw text body of a script element
+	contextScript
+	// contextStyle is the raw text body of a style element
+	contextStyle
+)
+
+// unsafeURL replaces URL attribute values whose scheme is not allowed
+const unsafeURL = "about:invalid#zforge-unsafe-url"
+
+var (
+	textEscaper = strings.NewReplacer(
+		"&", "&amp;",
+		"<", "&lt;",
+		">", "&gt;",
+	)
+	attrEscaper = strings.NewReplacer(
+		"&", "&amp;",
+		"<", "&lt;",
+		">", "&gt;",
+		`"`, "&#34;",
+		"'", "&#39;",
+	)
+)
+
+// urlAttributes lists attributes whose values are interpreted as URLs
+var urlAttributes = map[string]bool{
+	"action":     true,
+	"background": true,
+	"cite":       true,
+	"codebase":   true,
+	"data":       true,
+	"formaction": true,
+	"href":       true,
+	"icon":       true,
+	"longdesc":   true,
+	"manifest":   true,
+	"ping":       true,
+	"poster":     true,
+	"src":        true,
+	"xlink:href": true,
+}
+
+// safeSchemes lists the URL schemes allowed in URL attributes
+var safeSchemes = map[string]bool{
+	"http":   true,
+	"https":  true,
+	"mailto": true,
+	"tel":    true,
+}
+
+// contextFor returns the escaping context for the content of the given tag
+func contextFor(tag string) contentContext {
+	switch strings.ToLower(tag) {
+	case "script":
+		return contextScript
+	case "style":
+		return contextStyle
+	default:
+		return contextText
+	}
+}
+
+// writeContent writes text escaped for the given content context
+func writeContent(w htmlWriter, s string, ctx contentContext) {
+	switch ctx {
+	case contextScript:
+		writeRawText(w, s, "script")
+	case contextStyle:
+		writeRawText(w, s, "style")
+	default:
+		textEscaper.WriteString(w, s)
+	}
+}
+
+// writeRawText prevents raw text from closing its element early. Script and
+// style bodies are not entity-decoded by browsers, so only the sequences that
+// would end the element or open a comment are neutralised.
+func writeRawText(w htmlWriter, s, tag string) {
+	closing := "</" + tag
+	for {
+		i := strings.IndexByte(s, '<')
+		if i < 0 {
+			w.WriteString(s)
+			return
+		}
+		w.WriteString(s[:i])
+		s = s[i:]
+		switch {
+		case len(s) >= len(closing) && strings.EqualFold(s[:len(closing)], closing):
+			w.WriteString(`<\/`)
+			w.WriteString(s[2:len(closing)])
+			s = s[len(closing):]
+		case strings.HasPrefix(s, "<!--"):
+			w.WriteString(`<\!--`)
+			s = s[len("<!--"):]
+		default:
+			w.WriteByte('<')
+			s = s[1:]
+		}
+	}
+}
+
+// writeAttr writes an escaped attribute value, sanitising URL attributes first
+func writeAttr(w htmlWriter, key, value string) {
+	if urlAttributes[strings.ToLower(key)] {
+		value = sanitizeURL(value)
+	}
+	attrEscaper.WriteString(w, value)
+}
+
+// sanitizeURL returns the URL unchanged if it is relative or uses a safe
+// scheme, and a harmless placeholder otherwise
+func sanitizeURL(value string) string {
+	// Browsers ignore leading spaces and control characters and strip tabs
+	// and newlines anywhere in a URL, so "java\tscript:" must be caught too.
+	normalized := strings.Map(func(r rune) rune {
+		if r == '\t' || r == '\n' || r == '\r' {
+			return -1
+		}
+		return r
+	}, strings.TrimLeftFunc(value, func(r rune) bool { return r <= ' ' }))
+
+	colon := strings.IndexByte(normalized, ':')
+	if colon < 0 {
+		return value
+	}
+	// A colon after a path, query or fragment delimiter is not a scheme
+	if strings.ContainsAny(normalized[:colon], "/?#") {
+		return value
+	}
+	if safeSchemes[strings.ToLower(normalized[:colon])] {
+		return value
+	}
+	return unsafeURL
+}
+
+// validAttrName reports whether name can be written as an attribute name
+// without changing the structure of the tag
+func validAttrName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, r := range name {
+		switch {
+		case r <= ' ', r == 0x7f:
+			return false
+		case r == '"', r == '\'', r == '>', r == '/', r == '=', r == '<':
+			return false
+		}
+	}
+	return true
+}
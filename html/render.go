@@ -0,0 +1,283 @@
+package html
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"github.com/computesdk/zforge/css"
+)
+
+// CSSPlacement controls how the generated stylesheet is added to a document
+type CSSPlacement int
+
+const (
+	// CSSInline adds a style element with the minimal CSS to the head.
+	// Nothing is added when no classes were used.
+	CSSInline CSSPlacement = iota
+	// CSSLink adds a link element pointing at RenderOptions.StylesheetURL.
+	// Nothing is added when the URL is empty.
+	CSSLink
+	// CSSPlaceholder adds RenderOptions.Placeholder to the head as raw
+	// markup, so the caller can substitute a stylesheet later
+	CSSPlaceholder
+	// CSSNone adds nothing
+	CSSNone
+)
+
+// MissingHead controls what happens to the stylesheet when the tree has no
+// head element
+type MissingHead int
+
+const (
+	// MissingHeadSkip drops the stylesheet
+	MissingHeadSkip MissingHead = iota
+	// MissingHeadCreate adds a head element holding the stylesheet as the
+	// first child of the first html element. The stylesheet is dropped if
+	// there is no html element either.
+	MissingHeadCreate
+	// MissingHeadPrepend writes the stylesheet before the rendered tree,
+	// which suits fragments that are inserted into an existing page
+	MissingHeadPrepend
+)
+
+// ClassTracking selects how a render finds the classes to generate CSS for
+type ClassTracking int
+
+const (
+	// TrackClassCalls collects the classes set with Element.Class or
+	// Element.Attr, as long as the class attribute still holds them
+	TrackClassCalls ClassTracking = iota
+	// TrackClassAttributes collects the tokens of every class attribute in
+	// the tree, however it was set, so the stylesheet is purely a function
+	// of the output. Markup inside Raw elements is not inspected.
+	TrackClassAttributes
+)
+
+// DefaultCSSPlaceholder is the marker written by CSSPlaceholder when
+// RenderOptions.Placeholder is empty
+const DefaultCSSPlaceholder = "<!--zforge:css-->"
+
+// RenderOptions configures how an element tree is rendered. The zero value
+// inlines the minimal CSS into an existing head.
+type RenderOptions struct {
+	// CSS selects how the stylesheet is added to the document
+	CSS CSSPlacement
+	// StylesheetURL is the href of the link element used by CSSLink. No
+	// link is added when it is empty.
+	StylesheetURL string
+	// Placeholder is the raw markup used by CSSPlaceholder
+	Placeholder string
+	// MissingHead selects what happens when the tree has no head element
+	MissingHead MissingHead
+	// Tracker collects the classes used by the tree. When nil, each render
+	// uses a fresh tracker. Share one tracker to render several fragments
+	// and emit a single stylesheet for all of them.
+	Tracker *css.Tracker
+	// ClassTracking selects how classes are collected from the tree
+	ClassTracking ClassTracking
+}
+
+// RenderWith renders the element tree with the given options and returns the
+// final HTML string. The tree is never modified, so rendering is idempotent.
+func (e *Element) RenderWith(opts RenderOptions) string {
+	var b strings.Builder
+	e.writeDocument(&b, opts)
+	return b.String()
+}
+
+// WriteToWith streams the element tree rendered with the given options to w
+// through a buffered writer
+func (e *Element) WriteToWith(w io.Writer, opts RenderOptions) (int64, error) {
+	cw := &countingWriter{w: w}
+	bw := bufio.NewWriter(cw)
+	e.writeDocument(bw, opts)
+	err := bw.Flush()
+	return cw.n, err
+}
+
+// renderer carries the state of a single render
+type renderer struct {
+	w htmlWriter
+	// stylesheet is written into the first head, or into a created head,
+	// and cleared once written
+	stylesheet *Element
+	createHead bool
+}
+
+// writeDocument writes the element tree, adding the stylesheet as configured
+func (e *Element) writeDocument(w htmlWriter, opts RenderOptions) {
+	tracker := opts.Tracker
+	if tracker == nil {
+		tracker = css.NewTracker()
+	}
+	if opts.ClassTracking == TrackClassAttributes {
+		e.trackClassAttributes(tracker)
+	} else {
+		e.trackClasses(tracker)
+	}
+
+	r := &renderer{w: w, stylesheet: stylesheetElement(opts, tracker)}
+
+	if r.stylesheet != nil && e.findHead() == nil {
+		switch opts.MissingHead {
+		case MissingHeadCreate:
+			r.createHead = true
+		case MissingHeadPrepend:
+			r.write(r.stylesheet, contextText)
+			r.stylesheet = nil
+		default:
+			r.stylesheet = nil
+		}
+	}
+
+	r.write(e, contextText)
+}
+
+// stylesheetElement builds the element that carries the stylesheet, or nil
+// if nothing should be added
+func stylesheetElement(opts RenderOptions, tracker *css.Tracker) *Element {
+	switch opts.CSS {
+	case CSSInline:
+		if tracker.Len() == 0 {
+			return nil
+		}
+		return Style(tracker.GenerateMinimalCSS().Generate())
+	case CSSLink:
+		if opts.StylesheetURL == "" {
+			return nil
+		}
+		return Link().Attr("rel", "stylesheet").Attr("href", opts.StylesheetURL)
+	case CSSPlaceholder:
+		if opts.Placeholder == "" {
+			return Raw(DefaultCSSPlaceholder)
+		}
+		return Raw(opts.Placeholder)
+	default:
+		return nil
+	}
+}
+
+// trackClasses records the classes of the element tree with the tracker.
+// Classes whose attribute was since replaced or deleted through Attributes
+// are skipped.
+func (e *Element) trackClasses(t *css.Tracker) {
+	if value, ok := e.Attributes.Get("class"); ok && value == e.classAttr {
+		t.Track(e.classes...)
+	}
+	for i := range e.Children {
+		e.Children[i].trackClasses(t)
+	}
+}
+
+// trackClassAttributes records the tokens of every class attribute in the
+// element tree with the tracker
+func (e *Element) trackClassAttributes(t *css.Tracker) {
+	if value, ok := e.Attributes.Get("class"); ok {
+		for _, token := range strings.Fields(value) {
+			t.Track(css.Class(token))
+		}
+	}
+	for i := range e.Children {
+		e.Children[i].trackClassAttributes(t)
+	}
+}
+
+// findHead recursively searches for the first head element in the document tree
+func (e *Element) findHead() *Element {
+	// Check if this element is a head
+	if e.Tag == "head" {
+		return e
+	}
+
+	// Recursively search children
+	for i := range e.Children {
+		if found := e.Children[i].findHead(); found != nil {
+			return found
+		}
+	}
+
+	return nil
+}
+
+// write writes the element and its children as HTML.
+// ctx is the escaping context inherited from the parent element.
+func (r *renderer) write(e *Element, ctx contentContext) {
+	if e == nil {
+		return
+	}
+	w := r.w
+
+	// Handle text-only elements (no tag)
+	if e.Tag == "" {
+		if e.raw {
+			w.WriteString(e.Content)
+		} else {
+			writeContent(w, e.Content, ctx)
+		}
+		return
+	}
+
+	w.WriteByte('<')
+	w.WriteString(e.Tag)
+
+	for _, attr := range e.Attributes {
+		if !validAttrName(attr.Key) {
+			continue
+		}
+		w.WriteByte(' ')
+		w.WriteString(attr.Key)
+		w.WriteString(`="`)
+		writeAttr(w, attr.Key, attr.Value)
+		w.WriteByte('"')
+	}
+
+	if isSelfClosing(e.Tag) {
+		w.WriteString(" />")
+		return
+	}
+
+	w.WriteByte('>')
+
+	if r.createHead && e.Tag == "html" {
+		w.WriteString("<head>")
+		r.write(r.stylesheet, contextText)
+		w.WriteString("</head>")
+		r.createHead = false
+		r.stylesheet = nil
+	}
+
+	inner := contextFor(e.Tag)
+	if e.Content != "" {
+		if e.raw {
+			w.WriteString(e.Content)
+		} else {
+			writeContent(w, e.Content, inner)
+		}
+	}
+
+	for i := range e.Children {
+		r.write(&e.Children[i], inner)
+	}
+
+	if e.Tag == "head" && r.stylesheet != nil {
+		r.write(r.stylesheet, inner)
+		r.stylesheet = nil
+	}
+
+	w.WriteString("</")
+	w.WriteString(e.Tag)
+	w.WriteByte('>')
+}
+
+// countingWriter counts the bytes written to the underlying writer
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	return n, err
+}
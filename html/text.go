@@ -40,11 +40,18 @@ func Span(content string) *Element {
 	return &Element{Tag: "span", Content: content}
 }
 
-// Text creates a text-only element (no tag)
+// Text creates a text-only element (no tag). The content is escaped for
+// the context of its parent element when rendered.
 func Text(content string) *Element {
 	return &Element{Content: content}
 }
 
+// Raw creates a text-only element whose content is trusted markup and is
+// written to the output verbatim. Never pass user input to Raw.
+func Raw(markup string) *Element {
+	return &Element{Content: markup, raw: true}
+}
+
 // A creates a new anchor element
 func A() *Element {
 	return &Element{Tag: "a"}